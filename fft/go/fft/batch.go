@@ -0,0 +1,58 @@
+package fft
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// FFTBatch transforms every array in place with a single shared Plan,
+// spreading the arrays over GOMAXPROCS goroutines. All arrays must have the
+// same power-of-two length.
+func FFTBatch(arrays [][]Complex) error {
+	return FFTBatchN(arrays, runtime.GOMAXPROCS(0))
+}
+
+// FFTBatchN is FFTBatch with exactly workers goroutines pulling arrays from
+// a channel; workers <= 0 uses runtime.NumCPU(). The workers share one
+// read-only Plan and transform in place, so memory stays bounded however
+// many arrays there are.
+func FFTBatchN(arrays [][]Complex, workers int) error {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if len(arrays) == 0 {
+		return nil
+	}
+	n := len(arrays[0])
+	for i, arr := range arrays {
+		if len(arr) != n {
+			return fmt.Errorf("fft: %w: array %d has length %d, want %d", ErrLengthMismatch, i, len(arr), n)
+		}
+	}
+	if n == 0 {
+		return nil // like FFT, transforming empty arrays is a no-op
+	}
+	plan, err := NewPlan(n)
+	if err != nil {
+		return err
+	}
+
+	jobs := make(chan []Complex)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for arr := range jobs {
+				plan.FFT(arr)
+			}
+		}()
+	}
+	for _, arr := range arrays {
+		jobs <- arr
+	}
+	close(jobs)
+	wg.Wait()
+	return nil
+}
@@ -0,0 +1,66 @@
+package fft
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"testing"
+)
+
+func TestFFTBatchNMatchesSerial(t *testing.T) {
+	arrays := make([][]Complex, 37)
+	want := make([][]Complex, len(arrays))
+	for i := range arrays {
+		arrays[i] = randomSignal(256, int64(i))
+		want[i] = Clone(arrays[i])
+		FFT(want[i])
+	}
+	for _, workers := range []int{0, 1, 4} {
+		got := make([][]Complex, len(arrays))
+		for i := range arrays {
+			got[i] = Clone(arrays[i])
+		}
+		if err := FFTBatchN(got, workers); err != nil {
+			t.Fatalf("workers=%d: %v", workers, err)
+		}
+		for i := range got {
+			if d := maxDiff(got[i], want[i]); d > 1e-12 {
+				t.Errorf("workers=%d: array %d differs from FFT by %g", workers, i, d)
+			}
+		}
+	}
+}
+
+func TestFFTBatchNEdgeCases(t *testing.T) {
+	if err := FFTBatchN(nil, 2); err != nil {
+		t.Errorf("no arrays: %v", err)
+	}
+	if err := FFTBatchN([][]Complex{{}, {}}, 2); err != nil {
+		t.Errorf("empty arrays: %v", err)
+	}
+	err := FFTBatchN([][]Complex{make([]Complex, 8), make([]Complex, 4)}, 2)
+	if !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("mixed lengths: err = %v, want ErrLengthMismatch", err)
+	}
+}
+
+func BenchmarkFFTBatchN(b *testing.B) {
+	src := make([][]Complex, 256)
+	for i := range src {
+		src[i] = randomSignal(4096, int64(i))
+	}
+	arrays := make([][]Complex, len(src))
+	for i := range arrays {
+		arrays[i] = make([]Complex, len(src[i]))
+	}
+	for workers := 1; workers <= runtime.NumCPU(); workers *= 2 {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for b.Loop() {
+				for i := range arrays {
+					copy(arrays[i], src[i])
+				}
+				FFTBatchN(arrays, workers)
+			}
+		})
+	}
+}
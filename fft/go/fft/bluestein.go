@@ -0,0 +1,51 @@
+package fft
+
+import "math"
+
+func FFTArbitrary(arr []Complex) {
+	n := len(arr)
+	if n == 0 {
+		return
+	}
+	if IsPow2(n) {
+		FFT(arr)
+		return
+	}
+	bluestein(arr)
+	normalize(arr)
+}
+
+func bluestein(arr []Complex) {
+	n := len(arr)
+	m := NextPow2(2*n - 1)
+
+	// chirp[i] = exp(-pi*i*i/n); i*i is reduced mod 2n to keep the angle small
+	chirp := make([]Complex, n)
+	for i := range n {
+		ang := -math.Pi * float64(i*i%(2*n)) / float64(n)
+		chirp[i] = Complex{math.Cos(ang), math.Sin(ang)}
+	}
+
+	a := make([]Complex, m)
+	b := make([]Complex, m)
+	for i := range n {
+		a[i] = arr[i].Mul(chirp[i])
+	}
+	b[0] = chirp[0].Conj()
+	for i := 1; i < n; i++ {
+		b[i] = chirp[i].Conj()
+		b[m-i] = b[i]
+	}
+
+	fftCore(a, false)
+	fftCore(b, false)
+	for i := range m {
+		a[i] = a[i].Mul(b[i])
+	}
+	fftCore(a, true)
+
+	scale := 1.0 / float64(m)
+	for i := range n {
+		arr[i] = a[i].Mul(chirp[i]).MulScalar(scale)
+	}
+}
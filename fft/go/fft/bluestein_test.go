@@ -0,0 +1,14 @@
+package fft
+
+import "testing"
+
+func TestFFTArbitraryMatchesDFT(t *testing.T) {
+	for _, n := range []int{3, 6, 10, 1000} {
+		x := randomSignal(n, int64(n))
+		want := DFT(x)
+		FFTArbitrary(x)
+		if d := maxDiff(x, want); d > 1e-9 {
+			t.Errorf("n=%d: FFTArbitrary differs from DFT by %g", n, d)
+		}
+	}
+}
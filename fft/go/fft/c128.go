@@ -0,0 +1,39 @@
+package fft
+
+import (
+	"fmt"
+	"math"
+)
+
+// FFTC128 is FFT on Go's native complex128, transformed in place with no
+// conversion to Complex. len(arr) must be a power of two; other lengths
+// return ErrNotPowerOfTwo and leave arr untouched.
+func FFTC128(arr []complex128) error {
+	n := len(arr)
+	if n <= 1 {
+		return nil
+	}
+	if !IsPow2(n) {
+		return fmt.Errorf("fft: length %d is %w", n, ErrNotPowerOfTwo)
+	}
+	bitReverse(arr)
+	tw := cachedTwiddles(n)
+	for size := 2; size <= n; size <<= 1 {
+		half := size / 2
+		step := n / size
+		for start := 0; start < n; start += size {
+			for k := range half {
+				p := arr[start+k]
+				q := tw[k*step].ToC128() * arr[start+k+half]
+				arr[start+k] = p + q
+				arr[start+k+half] = p - q
+			}
+		}
+	}
+
+	s := complex(1/math.Sqrt(float64(n)), 0)
+	for i := range arr {
+		arr[i] *= s
+	}
+	return nil
+}
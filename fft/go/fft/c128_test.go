@@ -0,0 +1,35 @@
+package fft
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFFTC128MatchesFFT(t *testing.T) {
+	for _, n := range []int{1, 2, 8, 1024} {
+		x := randomSignal(n, int64(n))
+		arr := make([]complex128, n)
+		for i, c := range x {
+			arr[i] = c.ToC128()
+		}
+		FFT(x)
+		if err := FFTC128(arr); err != nil {
+			t.Fatalf("n=%d: %v", n, err)
+		}
+		got := make([]Complex, n)
+		for i, c := range arr {
+			got[i] = FromC128(c)
+		}
+		if d := maxDiff(got, x); d > 1e-12 {
+			t.Errorf("n=%d: max diff from FFT %g", n, d)
+		}
+	}
+
+	arr := []complex128{1, 2, 3}
+	if err := FFTC128(arr); !errors.Is(err, ErrNotPowerOfTwo) {
+		t.Errorf("n=3: err = %v, want ErrNotPowerOfTwo", err)
+	}
+	if arr[0] != 1 || arr[1] != 2 || arr[2] != 3 {
+		t.Errorf("n=3: arr modified to %v", arr)
+	}
+}
@@ -0,0 +1,25 @@
+package fft
+
+import "math"
+
+// Cepstrum returns the real cepstrum of signal, the real part of
+// IDFT(log|DFT(signal)|) with the inverse scaled by 1/n as in NumPy. A
+// component periodic every p samples shows up as a peak at quefrency p. Any
+// length is accepted; signal is left untouched. Epsilon is added to each
+// magnitude before the log so zero bins stay finite.
+func Cepstrum(signal []Complex) []float64 {
+	n := len(signal)
+	spec := make([]Complex, n)
+	copy(spec, signal)
+	rawTransform(spec)
+	for i, c := range spec {
+		spec[i] = Complex{math.Log(c.Abs() + Epsilon), 0}
+	}
+	rawInverseTransform(spec)
+
+	out := make([]float64, n)
+	for i, c := range spec {
+		out[i] = c.Real / float64(n)
+	}
+	return out
+}
@@ -0,0 +1,27 @@
+package fft
+
+import "testing"
+
+func TestCepstrumEchoPeak(t *testing.T) {
+	// An echo delayed by p samples multiplies the spectrum by 1+a·e^{-iωp},
+	// whose log ripples with period p in frequency: a cepstral peak at p.
+	const n, p = 1024, 50
+	src := randomSignal(n, 3)
+	x := make([]Complex, n)
+	for i := range x {
+		x[i] = Complex{src[i].Real, 0}
+		if i >= p {
+			x[i].Real += 0.6 * src[i-p].Real
+		}
+	}
+	c := Cepstrum(x)
+	best := 10
+	for q := best; q < n/2; q++ {
+		if c[q] > c[best] {
+			best = q
+		}
+	}
+	if best != p {
+		t.Errorf("cepstral peak at quefrency %d, want %d", best, p)
+	}
+}
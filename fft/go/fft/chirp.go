@@ -0,0 +1,16 @@
+package fft
+
+import "math"
+
+// Chirp returns n samples of a complex exponential whose frequency sweeps
+// linearly from f0 to f1, both in cycles per sample (0.5 is Nyquist). The
+// instantaneous frequency at sample t is f0 + (f1-f0)*t/n.
+func Chirp(n int, f0, f1 float64) []Complex {
+	out := make([]Complex, max(n, 0))
+	for i := range out {
+		t := float64(i)
+		phase := 2 * math.Pi * (f0*t + (f1-f0)*t*t/(2*float64(n)))
+		out[i] = FromPolar(1, phase)
+	}
+	return out
+}
@@ -1,21 +1,169 @@
 package fft
 
-type Complex struct {
-	Real, Imag float64
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+type Float interface {
+	~float32 | ~float64
+}
+
+type ComplexG[T Float] struct {
+	Real, Imag T
+}
+
+type Complex = ComplexG[float64]
+
+type Complex32 = ComplexG[float32]
+
+func (c ComplexG[T]) Add(other ComplexG[T]) ComplexG[T] {
+	return ComplexG[T]{c.Real + other.Real, c.Imag + other.Imag}
+}
+
+func (c ComplexG[T]) Sub(other ComplexG[T]) ComplexG[T] {
+	return ComplexG[T]{c.Real - other.Real, c.Imag - other.Imag}
+}
+
+func (c ComplexG[T]) Mul(other ComplexG[T]) ComplexG[T] {
+	return ComplexG[T]{c.Real*other.Real - c.Imag*other.Imag, c.Real*other.Imag + c.Imag*other.Real}
+}
+
+// MulI multiplies c by i, a quarter turn counterclockwise, without a full
+// Mul.
+func (c ComplexG[T]) MulI() ComplexG[T] {
+	return ComplexG[T]{-c.Imag, c.Real}
+}
+
+func (c ComplexG[T]) Neg() ComplexG[T] {
+	return ComplexG[T]{-c.Real, -c.Imag}
+}
+
+func (c ComplexG[T]) MulScalar(scalar T) ComplexG[T] {
+	return ComplexG[T]{c.Real * scalar, c.Imag * scalar}
+}
+
+func (c ComplexG[T]) AddScalar(scalar T) ComplexG[T] {
+	return ComplexG[T]{c.Real + scalar, c.Imag}
+}
+
+// Div follows float semantics: dividing by a zero Complex yields Inf/NaN
+// components rather than panicking.
+func (c ComplexG[T]) Div(other ComplexG[T]) ComplexG[T] {
+	d := other.Real*other.Real + other.Imag*other.Imag
+	return ComplexG[T]{(c.Real*other.Real + c.Imag*other.Imag) / d, (c.Imag*other.Real - c.Real*other.Imag) / d}
+}
+
+func (c ComplexG[T]) Abs() T {
+	return T(math.Hypot(float64(c.Real), float64(c.Imag)))
+}
+
+// Abs2 is |c|^2, cheaper than squaring Abs when only power is needed.
+func (c ComplexG[T]) Abs2() T {
+	return c.Real*c.Real + c.Imag*c.Imag
+}
+
+func (c ComplexG[T]) Phase() T {
+	return T(math.Atan2(float64(c.Imag), float64(c.Real)))
+}
+
+func (c ComplexG[T]) Conj() ComplexG[T] {
+	return ComplexG[T]{c.Real, -c.Imag}
+}
+
+func FromPolar(magnitude, phase float64) Complex {
+	return Complex{magnitude * math.Cos(phase), magnitude * math.Sin(phase)}
+}
+
+func FromC128(v complex128) Complex {
+	return Complex{real(v), imag(v)}
+}
+
+func (c ComplexG[T]) ToC128() complex128 {
+	return complex(float64(c.Real), float64(c.Imag))
+}
+
+func FromComplex128(src []complex128) []Complex {
+	dst := make([]Complex, len(src))
+	for i, v := range src {
+		dst[i] = FromC128(v)
+	}
+	return dst
+}
+
+func ToComplex128(src []Complex) []complex128 {
+	dst := make([]complex128, len(src))
+	for i, c := range src {
+		dst[i] = c.ToC128()
+	}
+	return dst
+}
+
+func (c ComplexG[T]) Equal(other ComplexG[T]) bool {
+	return c.Real == other.Real && c.Imag == other.Imag
+}
+
+// Epsilon is the package's default guard against zeros: ApproxEqual falls
+// back to it when passed a zero tolerance, Whiten treats magnitudes up to it
+// as zero, Cepstrum adds it to magnitudes before the log and ToDecibels
+// clamps ratios below it.
+var Epsilon = 1e-12
+
+// ApproxEqual reports whether both components differ by less than tol, or by
+// less than Epsilon when tol is 0.
+func (c ComplexG[T]) ApproxEqual(other ComplexG[T], tol T) bool {
+	if tol == 0 {
+		tol = T(Epsilon)
+	}
+	return T(math.Abs(float64(c.Real-other.Real))) < tol && T(math.Abs(float64(c.Imag-other.Imag))) < tol
 }
 
-func (c Complex) Add(other Complex) Complex {
-	return Complex{c.Real + other.Real, c.Imag + other.Imag}
+// IsZero reports whether both components are zero; -0 counts as zero.
+func (c ComplexG[T]) IsZero() bool {
+	return c.Real == 0 && c.Imag == 0
 }
 
-func (c Complex) Sub(other Complex) Complex {
-	return Complex{c.Real - other.Real, c.Imag - other.Imag}
+func (c ComplexG[T]) IsNaN() bool {
+	return math.IsNaN(float64(c.Real)) || math.IsNaN(float64(c.Imag))
 }
 
-func (c Complex) Mul(other Complex) Complex {
-	return Complex{c.Real*other.Real - c.Imag*other.Imag, c.Real*other.Imag + c.Imag*other.Real}
+// String formats c as a+bi, or just a when the imaginary part is zero.
+func (c ComplexG[T]) String() string {
+	if c.Imag == 0 {
+		return fmt.Sprint(c.Real)
+	}
+	sign, imag := "+", c.Imag
+	if math.Signbit(float64(imag)) {
+		sign, imag = "-", -imag
+	}
+	return fmt.Sprint(c.Real) + sign + strings.TrimPrefix(fmt.Sprint(imag), "+") + "i"
 }
 
-func (c Complex) MulScalar(scalar float64) Complex {
-	return Complex{c.Real * scalar, c.Imag * scalar}
+// ParseComplex accepts the a+bi form produced by String (including a lone
+// real or imaginary part such as 1.5 or -3i) as well as a (real,imag) pair.
+func ParseComplex(s string) (Complex, error) {
+	text := strings.TrimSpace(s)
+	if inner, ok := strings.CutPrefix(text, "("); ok && strings.Contains(inner, ",") {
+		inner, ok = strings.CutSuffix(inner, ")")
+		if !ok {
+			return Complex{}, fmt.Errorf("fft: invalid complex number %q: missing closing parenthesis", s)
+		}
+		re, im, _ := strings.Cut(inner, ",")
+		r, err := strconv.ParseFloat(strings.TrimSpace(re), 64)
+		if err != nil {
+			return Complex{}, fmt.Errorf("fft: invalid complex number %q: bad real part", s)
+		}
+		i, err := strconv.ParseFloat(strings.TrimSpace(im), 64)
+		if err != nil {
+			return Complex{}, fmt.Errorf("fft: invalid complex number %q: bad imaginary part", s)
+		}
+		return Complex{r, i}, nil
+	}
+	v, err := strconv.ParseComplex(text, 128)
+	if err != nil {
+		return Complex{}, fmt.Errorf("fft: invalid complex number %q", s)
+	}
+	return FromC128(v), nil
 }
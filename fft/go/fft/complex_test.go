@@ -0,0 +1,41 @@
+package fft
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDiv(t *testing.T) {
+	tests := []struct {
+		a, b, want Complex
+	}{
+		{Complex{1, 0}, Complex{1, 0}, Complex{1, 0}},
+		{Complex{1, 0}, Complex{0, 1}, Complex{0, -1}},
+		{Complex{3, 4}, Complex{1, 2}, Complex{2.2, -0.4}},
+		{Complex{-6, 8}, Complex{2, 0}, Complex{-3, 4}},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Div(tt.b); !got.ApproxEqual(tt.want, 1e-12) {
+			t.Errorf("%v.Div(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+
+	got := Complex{1, 1}.Div(Complex{})
+	if !math.IsInf(got.Real, 0) && !math.IsNaN(got.Real) {
+		t.Errorf("division by zero = %v, want Inf or NaN components", got)
+	}
+}
+
+func TestMulIAndNeg(t *testing.T) {
+	for _, c := range []Complex{{0, 0}, {1, 0}, {0, 1}, {3, -4}, {-2.5, 1e-300}, randomSignal(1, 7)[0]} {
+		if got, want := c.MulI(), c.Mul(Complex{0, 1}); got != want {
+			t.Errorf("%v.MulI() = %v, want %v", c, got, want)
+		}
+		if got := c.MulI().MulI(); got != c.Neg() {
+			t.Errorf("%v.MulI().MulI() = %v, want %v", c, got, c.Neg())
+		}
+		if got := c.Neg(); got != (Complex{-c.Real, -c.Imag}) || got.Add(c) != (Complex{}) {
+			t.Errorf("%v.Neg() = %v", c, got)
+		}
+	}
+}
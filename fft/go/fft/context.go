@@ -0,0 +1,32 @@
+package fft
+
+import (
+	"context"
+	"fmt"
+)
+
+// FFTContext is FFT with ctx checked between butterfly stages. When ctx is
+// done it returns ctx.Err() and leaves arr partially transformed.
+func FFTContext(ctx context.Context, arr []Complex) error {
+	n := len(arr)
+	if n == 0 {
+		return nil
+	}
+	if !IsPow2(n) {
+		return fmt.Errorf("fft: length %d is %w", n, ErrNotPowerOfTwo)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	BitReverse(arr)
+	tw := twiddles(n, -1)
+	for size := 2; size <= n; size <<= 1 {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		stage(arr, tw, size, nil)
+	}
+	normalize(arr)
+	return nil
+}
@@ -0,0 +1,158 @@
+package fft
+
+import (
+	"fmt"
+	"math"
+)
+
+// Convolve returns the linear convolution of a and b, of length
+// len(a)+len(b)-1.
+func Convolve(a, b []Complex) []Complex {
+	if len(a) == 0 || len(b) == 0 {
+		return []Complex{}
+	}
+	size := len(a) + len(b) - 1
+	fa, fb := spectra(a, b, NextPow2(size))
+	for i := range fa {
+		fa[i] = fa[i].Mul(fb[i])
+	}
+	return inverse(fa)[:size]
+}
+
+// ConvolveReal is Convolve for real signals, computed with RFFT and IRFFT
+// over half spectra, about twice as fast as the complex path.
+func ConvolveReal(a, b []float64) []float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return []float64{}
+	}
+	size := len(a) + len(b) - 1
+	m := NextPow2(size)
+	pa := make([]float64, m)
+	pb := make([]float64, m)
+	copy(pa, a)
+	copy(pb, b)
+	fa, fb := RFFT(pa), RFFT(pb)
+	for i := range fa {
+		fa[i] = fa[i].Mul(fb[i])
+	}
+	out := IRFFT(fa, m)[:size]
+	// as in inverse, the product of orthonormal spectra is short by sqrt(m)
+	s := math.Sqrt(float64(m))
+	for i := range out {
+		out[i] *= s
+	}
+	return out
+}
+
+// CircularConvolve returns the length-n circular convolution
+// c[k] = sum a[j]*b[(k-j) mod n] of two signals of the same power-of-two
+// length n. Unlike Convolve nothing is zero-padded, so the tail of the
+// linear convolution wraps around onto the start.
+func CircularConvolve(a, b []Complex) ([]Complex, error) {
+	n := len(a)
+	if len(b) != n {
+		return nil, fmt.Errorf("fft: %w: %d and %d", ErrLengthMismatch, n, len(b))
+	}
+	if n == 0 {
+		return []Complex{}, nil
+	}
+	if !IsPow2(n) {
+		return nil, fmt.Errorf("fft: length %d is %w", n, ErrNotPowerOfTwo)
+	}
+	fa, fb := spectra(a, b, n)
+	for i := range fa {
+		fa[i] = fa[i].Mul(fb[i])
+	}
+	return inverse(fa), nil
+}
+
+// OverlapAdd computes the same linear convolution as Convolve by splitting
+// signal into blocks of blockSize-len(kernel)+1 samples, so the transforms
+// never grow beyond blockSize. blockSize must be a power of two larger than
+// len(kernel).
+func OverlapAdd(signal, kernel []Complex, blockSize int) ([]Complex, error) {
+	m := len(kernel)
+	if !IsPow2(blockSize) {
+		return nil, fmt.Errorf("fft: block size %d is %w", blockSize, ErrNotPowerOfTwo)
+	}
+	if blockSize <= m {
+		return nil, fmt.Errorf("fft: %w: block size %d must be larger than kernel length %d", ErrLengthMismatch, blockSize, m)
+	}
+	if len(signal) == 0 || m == 0 {
+		return []Complex{}, nil
+	}
+
+	h := make([]Complex, blockSize)
+	copy(h, kernel)
+	FFT(h)
+
+	out := make([]Complex, len(signal)+m-1)
+	block := make([]Complex, blockSize)
+	step := blockSize - m + 1
+	for start := 0; start < len(signal); start += step {
+		clear(block)
+		copy(block, signal[start:min(start+step, len(signal))])
+		FFT(block)
+		for i := range block {
+			block[i] = block[i].Mul(h[i])
+		}
+		inverse(block)
+		for i := range min(blockSize, len(out)-start) {
+			out[start+i] = out[start+i].Add(block[i])
+		}
+	}
+	return out, nil
+}
+
+// CrossCorrelate returns r[k] = sum a[j+k]*conj(b[j]) in natural (unshifted)
+// order: index k holds lag k for k >= 0 and index len(r)-k holds lag -k.
+// The signals are zero-padded to a power of two of at least
+// len(a)+len(b)-1, so lags never wrap onto each other. If a is b delayed by
+// d samples the peak is at index d.
+func CrossCorrelate(a, b []Complex) []Complex {
+	if len(a) == 0 || len(b) == 0 {
+		return []Complex{}
+	}
+	fa, fb := spectra(a, b, NextPow2(len(a)+len(b)-1))
+	for i := range fa {
+		fa[i] = fa[i].Mul(fb[i].Conj())
+	}
+	return inverse(fa)
+}
+
+// AutoCorrelate returns r[k] = sum x[j+k]*conj(x[j]) for the lags
+// k = 0 ... len(signal)-1, with lag 0 at index 0. Negative lags are omitted
+// since r[-k] = conj(r[k]). The signal is zero-padded as in CrossCorrelate,
+// so the lags do not wrap.
+func AutoCorrelate(signal []Complex) []Complex {
+	n := len(signal)
+	if n == 0 {
+		return []Complex{}
+	}
+	spec := make([]Complex, NextPow2(2*n-1))
+	copy(spec, signal)
+	FFT(spec)
+	for i, c := range spec {
+		spec[i] = Complex{c.Abs2(), 0}
+	}
+	return inverse(spec)[:n]
+}
+
+// spectra zero-pads a and b to length m and transforms them.
+func spectra(a, b []Complex, m int) ([]Complex, []Complex) {
+	fa := make([]Complex, m)
+	fb := make([]Complex, m)
+	copy(fa, a)
+	copy(fb, b)
+	FFT(fa)
+	FFT(fb)
+	return fa, fb
+}
+
+// inverse undoes a product of two orthonormal spectra, which is short of the
+// true unnormalized product by a factor of sqrt(len(spec)).
+func inverse(spec []Complex) []Complex {
+	IFFT(spec)
+	scale(spec, math.Sqrt(float64(len(spec))))
+	return spec
+}
@@ -0,0 +1,141 @@
+package fft
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// argmax returns the index of the element with the largest magnitude.
+func argmax(arr []Complex) int {
+	best := 0
+	for i, c := range arr {
+		if c.Abs() > arr[best].Abs() {
+			best = i
+		}
+	}
+	return best
+}
+
+func TestCrossCorrelatePeakAtLag(t *testing.T) {
+	b := randomSignal(50, 1)
+	for _, d := range []int{0, 1, 7, 20} {
+		a := make([]Complex, len(b)+d)
+		copy(a[d:], b)
+		r := CrossCorrelate(a, b)
+		if got := argmax(r); got != d {
+			t.Errorf("a = b delayed by %d: peak at %d", d, got)
+		}
+	}
+
+	// b delayed relative to a gives a negative lag, at the end of r
+	a := b
+	delayed := make([]Complex, len(b)+3)
+	copy(delayed[3:], b)
+	r := CrossCorrelate(a, delayed)
+	if got := argmax(r); got != len(r)-3 {
+		t.Errorf("lag -3: peak at %d, want %d", got, len(r)-3)
+	}
+}
+
+func TestOverlapAddMatchesConvolve(t *testing.T) {
+	signal := randomSignal(1000, 1)
+	for _, m := range []int{1, 5, 31} {
+		kernel := randomSignal(m, int64(m))
+		want := Convolve(signal, kernel)
+		for _, block := range []int{32, 64, 256} {
+			got, err := OverlapAdd(signal, kernel, block)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != len(want) {
+				t.Fatalf("kernel %d, block %d: length %d, want %d", m, block, len(got), len(want))
+			}
+			if d := maxDiff(got, want); d > 1e-10 {
+				t.Errorf("kernel %d, block %d: differs from Convolve by %g", m, block, d)
+			}
+		}
+	}
+}
+
+func TestOverlapAddValidation(t *testing.T) {
+	kernel := randomSignal(8, 1)
+	if _, err := OverlapAdd(kernel, kernel, 24); !errors.Is(err, ErrNotPowerOfTwo) {
+		t.Errorf("block 24: err = %v, want ErrNotPowerOfTwo", err)
+	}
+	if _, err := OverlapAdd(kernel, kernel, 8); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("block 8 with kernel 8: err = %v, want ErrLengthMismatch", err)
+	}
+}
+
+func TestCircularConvolveMatchesSum(t *testing.T) {
+	for _, n := range []int{1, 2, 8, 16} {
+		a, b := randomSignal(n, 1), randomSignal(n, 2)
+		want := make([]Complex, n)
+		for i := range want {
+			for j := range n {
+				want[i] = want[i].Add(a[j].Mul(b[(i-j+n)%n]))
+			}
+		}
+		got, err := CircularConvolve(a, b)
+		if err != nil {
+			t.Fatalf("n=%d: %v", n, err)
+		}
+		if d := maxDiff(got, want); d > 1e-12 {
+			t.Errorf("n=%d: CircularConvolve differs from the direct sum by %g", n, d)
+		}
+	}
+	if _, err := CircularConvolve(make([]Complex, 4), make([]Complex, 8)); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("mismatched lengths: err = %v, want ErrLengthMismatch", err)
+	}
+	if _, err := CircularConvolve(make([]Complex, 6), make([]Complex, 6)); !errors.Is(err, ErrNotPowerOfTwo) {
+		t.Errorf("length 6: err = %v, want ErrNotPowerOfTwo", err)
+	}
+}
+
+func TestAutoCorrelatePeriodicPeaks(t *testing.T) {
+	const n, period = 128, 16
+	pattern := randomSignal(period, 5)
+	x := make([]Complex, n)
+	for i := range x {
+		x[i] = pattern[i%period]
+	}
+	r := AutoCorrelate(x)
+	if len(r) != n {
+		t.Fatalf("len = %d, want %d", len(r), n)
+	}
+	if got := argmax(r); got != 0 {
+		t.Errorf("largest value at lag %d, want 0", got)
+	}
+	// each multiple of the period beats every lag within half a period of it
+	for lag := period; lag+period/2 < n; lag += period {
+		if got := lag - period/2 + argmax(r[lag-period/2:lag+period/2]); got != lag {
+			t.Errorf("peak near lag %d is at %d", lag, got)
+		}
+	}
+}
+
+func TestConvolveRealMatchesSum(t *testing.T) {
+	for _, size := range [][2]int{{1, 1}, {5, 3}, {16, 16}, {100, 7}} {
+		a, b := randomReal(size[0], 1), randomReal(size[1], 2)
+		want := make([]float64, len(a)+len(b)-1)
+		for i, x := range a {
+			for j, y := range b {
+				want[i+j] += x * y
+			}
+		}
+		got := ConvolveReal(a, b)
+		if len(got) != len(want) {
+			t.Fatalf("%dx%d: len = %d, want %d", size[0], size[1], len(got), len(want))
+		}
+		for i := range want {
+			if math.Abs(got[i]-want[i]) > 1e-12 {
+				t.Errorf("%dx%d: element %d = %g, want %g", size[0], size[1], i, got[i], want[i])
+				break
+			}
+		}
+	}
+	if got := ConvolveReal(nil, []float64{1}); len(got) != 0 {
+		t.Errorf("ConvolveReal(nil, [1]) = %v, want []", got)
+	}
+}
@@ -1,39 +1,257 @@
 package fft
 
-import "math"
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"sync"
+	"sync/atomic"
+)
 
-func FFT(arr []Complex) {
-	fft(arr)
-	factor := 1.0 / math.Sqrt(float64(len(arr)))
-	for i := range len(arr) {
-		arr[i] = arr[i].MulScalar(factor)
+// FFT is FFTUnnormalized followed by orthonormal 1/sqrt(n) scaling, so IFFT
+// inverts it exactly. Lengths 0 and 1 are returned unchanged: the DFT of one
+// point is itself.
+func FFT[T Float](arr []ComplexG[T]) {
+	if len(arr) <= 1 {
+		return
+	}
+	FFTUnnormalized(arr)
+	normalize(arr)
+}
+
+// DetectZeroInput makes FFT, FFTUnnormalized and IFFT skip the transform
+// when every element of arr is zero, since the transform of zeros is zeros.
+// Like DetectRealInput it costs an O(n) scan of every input, so it is off by
+// default; it pays off when many frames are silent.
+var DetectZeroInput = false
+
+// FFTUnnormalized is the forward transform without the 1/sqrt(n) pass over
+// the output, for chains that apply their own scaling once at the end.
+// Scaling its result by 1/sqrt(n) gives FFT. len(arr) must be a power of
+// two.
+func FFTUnnormalized[T Float](arr []ComplexG[T]) {
+	switch {
+	case len(arr) <= 1:
+	case DetectZeroInput && isZero(arr):
+	case DetectRealInput && isReal(arr):
+		fftReal(arr)
+	default:
+		fftCore(arr, false)
+	}
+}
+
+// FFTRaw is FFTUnnormalized under its original name; both stay supported.
+func FFTRaw[T Float](arr []ComplexG[T]) {
+	FFTUnnormalized(arr)
+}
+
+func isZero[T Float](arr []ComplexG[T]) bool {
+	for _, c := range arr {
+		if !c.IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// Transform runs FFT on arr and returns it for chaining. arr itself is
+// still overwritten with the spectrum.
+func Transform[T Float](arr []ComplexG[T]) []ComplexG[T] {
+	FFT(arr)
+	return arr
+}
+
+// FFTCopy returns the FFT of arr in a new slice, leaving arr untouched.
+func FFTCopy[T Float](arr []ComplexG[T]) []ComplexG[T] {
+	out := make([]ComplexG[T], len(arr))
+	copy(out, arr)
+	FFT(out)
+	return out
+}
+
+func FFTChecked[T Float](arr []ComplexG[T]) error {
+	n := len(arr)
+	if n == 0 {
+		return nil
 	}
+	if !IsPow2(n) {
+		return fmt.Errorf("fft: length %d is %w", n, ErrNotPowerOfTwo)
+	}
+	FFT(arr)
+	return nil
 }
 
-func fft(arr []Complex) {
+// FFTInto is FFT using scratch, which must hold at least len(arr) elements,
+// as working space, so that repeated calls do not allocate.
+func FFTInto(arr, scratch []Complex) error {
 	n := len(arr)
-	if n == 1 {
+	if n == 0 {
+		return nil
+	}
+	if !IsPow2(n) {
+		return fmt.Errorf("fft: length %d is %w", n, ErrNotPowerOfTwo)
+	}
+	if len(scratch) < n {
+		return fmt.Errorf("fft: %w: scratch length %d is shorter than input length %d", ErrLengthMismatch, len(scratch), n)
+	}
+	fftScratch(arr, scratch[:n], cachedTwiddles(n), -1, nil)
+	normalize(arr)
+	return nil
+}
+
+// IFFT inverts FFT. In float64 the round trip IFFT(FFT(x)) reproduces
+// random input of unit magnitude to within 2*log2(n) machine epsilons per
+// element (under 1e-14 for lengths up to 2^20).
+func IFFT[T Float](arr []ComplexG[T]) {
+	if len(arr) <= 1 || DetectZeroInput && isZero(arr) {
 		return
 	}
+	fftCore(arr, true)
+	normalize(arr)
+}
 
-	a0 := make([]Complex, 0, n/2)
-	a1 := make([]Complex, 0, n/2)
-	for i := range n / 2 {
-		a0 = append(a0, arr[2*i])
-		a1 = append(a1, arr[2*i+1])
+func normalize[T Float](arr []ComplexG[T]) {
+	scale(arr, T(1.0/math.Sqrt(float64(len(arr)))))
+}
+
+// fftCore is the unnormalized transform shared by every forward and inverse
+// entry point; inverse only flips the sign of the twiddle angles. It borrows
+// a scratch buffer of len(arr) from scratchPool and recurses through
+// fftScratch.
+func fftCore[T Float](arr []ComplexG[T], inverse bool) {
+	sign := -1.0
+	if inverse {
+		sign = 1
 	}
+	scratch := getScratch[T](len(arr))
+	fftScratch(arr, *scratch, cachedTwiddles(len(arr)), sign, nil)
+	putScratch(scratch)
+}
+
+// scratchPools and scratchPools32 hold reusable scratch buffers of each
+// precision, indexed by log2 of their length, so repeated transforms of any
+// size stop allocating.
+var scratchPools, scratchPools32 [64]sync.Pool
 
-	fft(a0)
-	fft(a1)
+// scratchPool returns the pools for buffers of ComplexG[T].
+func scratchPool[T Float]() *[64]sync.Pool {
+	if _, ok := any(T(0)).(float32); ok {
+		return &scratchPools32
+	}
+	return &scratchPools
+}
 
-	ang := -2 * math.Pi / float64(n)
-	w := Complex{1, 0}
-	wn := Complex{math.Cos(ang), math.Sin(ang)}
+func getScratch[T Float](n int) *[]ComplexG[T] {
+	if IsPow2(n) {
+		if buf, ok := scratchPool[T]()[bits.TrailingZeros(uint(n))].Get().(*[]ComplexG[T]); ok {
+			return buf
+		}
+	}
+	buf := make([]ComplexG[T], n)
+	return &buf
+}
+
+func putScratch[T Float](buf *[]ComplexG[T]) {
+	if n := len(*buf); IsPow2(n) {
+		scratchPool[T]()[bits.TrailingZeros(uint(n))].Put(buf)
+	}
+}
+
+// twiddleCache holds the forward twiddle table of each power-of-two length,
+// indexed by log2(n). Stored tables are never modified.
+var twiddleCache [64]atomic.Pointer[[]Complex]
+
+//go:generate go run gen_twiddles.go
+
+// cachedTwiddles returns twiddles(n, -1), taken from bakedTwiddles when the
+// length has a generated table and otherwise computed on the first call for
+// each length.
+func cachedTwiddles(n int) []Complex {
+	if !IsPow2(n) {
+		return twiddles(n, -1)
+	}
+	lg := bits.TrailingZeros(uint(n))
+	if lg < len(bakedTwiddles) && bakedTwiddles[lg] != nil {
+		return bakedTwiddles[lg]
+	}
+	slot := &twiddleCache[lg]
+	if tw := slot.Load(); tw != nil {
+		return *tw
+	}
+	tw := twiddles(n, -1)
+	slot.Store(&tw)
+	return tw
+}
+
+// fftScratch splits arr into the halves of scratch and recurses with the
+// roles swapped, so arr doubles as the scratch space of the sub-transforms.
+// tw is the forward twiddle table of the top-level length; every level
+// reads it with a stride. A non-nil ops tallies the butterfly operations.
+func fftScratch[T Float](arr, scratch []ComplexG[T], tw []Complex, sign float64, ops *opCounter) {
+	n := len(arr)
+	switch n {
+	case 1:
+		return
+	case 2:
+		arr[0], arr[1] = arr[0].Add(arr[1]), arr[0].Sub(arr[1])
+		ops.add(2, 0)
+		return
+	case 4:
+		fft4(arr, sign)
+		ops.add(8, 0)
+		return
+	}
+
+	a0, a1 := deinterleave(arr, scratch)
+	fftScratch(a0, arr[:n/2], tw, sign, ops)
+	fftScratch(a1, arr[n/2:], tw, sign, ops)
+	merge(arr, a0, a1, tw, sign, ops)
+}
+
+// fft4 is the length-4 transform, whose twiddles 1 and sign*i reduce to
+// additions and component swaps.
+func fft4[T Float](arr []ComplexG[T], sign float64) {
+	s0, d0 := arr[0].Add(arr[2]), arr[0].Sub(arr[2])
+	s1, d1 := arr[1].Add(arr[3]), arr[1].Sub(arr[3])
+	r := ComplexG[T]{T(-sign) * d1.Imag, T(sign) * d1.Real} // sign*i * d1
+	arr[0] = s0.Add(s1)
+	arr[1] = d0.Add(r)
+	arr[2] = s0.Sub(s1)
+	arr[3] = d0.Sub(r)
+}
+
+// deinterleave copies the even samples of arr into the first half of
+// scratch and the odd samples into the second half.
+func deinterleave[T Float](arr, scratch []ComplexG[T]) ([]ComplexG[T], []ComplexG[T]) {
+	n := len(arr)
+	a0, a1 := scratch[:n/2], scratch[n/2:n]
 	for i := range n / 2 {
+		a0[i] = arr[2*i]
+		a1[i] = arr[2*i+1]
+	}
+	return a0, a1
+}
+
+// merge combines the half transforms a0 and a1 into arr. The twiddles come
+// from the forward table tw, conjugated for the inverse, instead of a
+// running product that accumulates phase error.
+func merge[T Float](arr, a0, a1 []ComplexG[T], tw []Complex, sign float64, ops *opCounter) {
+	n := len(arr)
+	stride := 2 * len(tw) / n
+	var adds, muls int
+	for i := range n / 2 {
+		t := tw[i*stride]
+		w := ComplexG[T]{T(t.Real), T(-sign * t.Imag)}
 		p := a0[i]
 		q := w.Mul(a1[i])
 		arr[i] = p.Add(q)
 		arr[i+n/2] = p.Sub(q)
-		w = w.Mul(wn)
+		adds += 2
+		muls++
 	}
+	ops.add(adds, muls)
+}
+
+func FFT32(arr []Complex32) {
+	FFT(arr)
 }
@@ -0,0 +1,135 @@
+package fft
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFFTCoreDirections(t *testing.T) {
+	conj := func(arr []Complex) []Complex {
+		out := make([]Complex, len(arr))
+		for i, c := range arr {
+			out[i] = c.Conj()
+		}
+		return out
+	}
+	for _, n := range []int{1, 2, 4, 8, 64, 512, 2048} {
+		x := randomSignal(n, int64(n))
+		s := 1 / math.Sqrt(float64(n))
+
+		fwd := Clone(x)
+		fftCore(fwd, false)
+		ScaleInPlace(fwd, s)
+		if d := maxDiff(fwd, DFT(x)); d > 1e-10 {
+			t.Errorf("n=%d: forward fftCore differs from DFT by %g", n, d)
+		}
+
+		// the inverse DFT is the conjugate of the DFT of the conjugate
+		inv := Clone(x)
+		fftCore(inv, true)
+		ScaleInPlace(inv, s)
+		if d := maxDiff(inv, conj(DFT(conj(x)))); d > 1e-10 {
+			t.Errorf("n=%d: inverse fftCore differs from the inverse DFT by %g", n, d)
+		}
+	}
+}
+
+func TestDetectZeroInput(t *testing.T) {
+	defer func(old bool) { DetectZeroInput = old }(DetectZeroInput)
+	DetectZeroInput = true
+
+	const n = 64
+	zeros := make([]Complex, n)
+	FFT(zeros)
+	IFFT(zeros)
+	for i, c := range zeros {
+		if !c.IsZero() {
+			t.Fatalf("all-zero input: element %d = %v after FFT and IFFT", i, c)
+		}
+	}
+
+	// one nonzero sample anywhere must disable the shortcut
+	for _, p := range []int{0, 1, n / 2, n - 1} {
+		x := make([]Complex, n)
+		x[p] = Complex{1, -2}
+		want := DFT(x)
+		FFT(x)
+		if d := maxDiff(x, want); d > 1e-12 {
+			t.Errorf("impulse at %d: FFT differs from DFT by %g", p, d)
+		}
+	}
+}
+
+func TestTrivialLengths(t *testing.T) {
+	noErr := func(f func([]Complex)) func([]Complex) error {
+		return func(arr []Complex) error { f(arr); return nil }
+	}
+	transforms := map[string]func([]Complex) error{
+		"FFT":             noErr(FFT[float64]),
+		"IFFT":            noErr(IFFT[float64]),
+		"FFTUnnormalized": noErr(FFTUnnormalized[float64]),
+		"FFTRaw":          noErr(FFTRaw[float64]),
+		"FFTChecked":      FFTChecked[float64],
+		"FFTArbitrary":    noErr(FFTArbitrary),
+		"FFTDIF":          FFTDIF,
+		"FFTIterative":    FFTIterative,
+		"FFTMixedRadix":   noErr(FFTMixedRadix),
+		"FFTParallel":     noErr(FFTParallel),
+		"FFTStockham":     noErr(FFTStockham),
+		"FFTRadix4":       FFTRadix4,
+		"FFTSplitRadix":   FFTSplitRadix,
+	}
+	for name, transform := range transforms {
+		empty := []Complex{}
+		if err := transform(empty); err != nil || len(empty) != 0 {
+			t.Errorf("%s(empty) = %v, err %v", name, empty, err)
+		}
+		one := []Complex{{1.5, -2}}
+		if err := transform(one); err != nil || one[0] != (Complex{1.5, -2}) {
+			t.Errorf("%s([1.5-2i]) = %v, err %v; the DFT of one point is itself", name, one, err)
+		}
+	}
+}
+
+// TestOutputOrder pins the natural bin order: DC at 0, positive frequencies
+// ascending, then the negative ones, with -k at n-k.
+func TestOutputOrder(t *testing.T) {
+	const n = 64
+	for _, k := range []int{0, 1, 5, n / 2, 40, n - 1} {
+		tone := make([]Complex, n)
+		cosine := make([]Complex, n)
+		for i := range tone {
+			ang := 2 * math.Pi * float64(k*i) / n
+			tone[i] = FromPolar(1, ang)
+			cosine[i] = Complex{math.Cos(ang), 0}
+		}
+		FFT(tone)
+		FFT(cosine)
+
+		want := make([]Complex, n)
+		want[k] = Complex{math.Sqrt(n), 0}
+		if d := maxDiff(tone, want); d > 1e-12 {
+			t.Errorf("k=%d: e^(2*pi*i*k*t/n) is not a single bin at %d (off by %g)", k, k, d)
+		}
+		// the cosine is half the tone plus half its conjugate at n-k
+		want = make([]Complex, n)
+		want[k].Real += math.Sqrt(n) / 2
+		want[(n-k)%n].Real += math.Sqrt(n) / 2
+		if d := maxDiff(cosine, want); d > 1e-12 {
+			t.Errorf("k=%d: cosine is not split between bins %d and %d (off by %g)", k, k, (n-k)%n, d)
+		}
+	}
+}
+
+func TestFFTUnnormalizedScaling(t *testing.T) {
+	for _, n := range []int{2, 8, 64, 1024} {
+		x := randomSignal(n, int64(n))
+		raw := Clone(x)
+		FFTUnnormalized(raw)
+		ScaleInPlace(raw, 1/math.Sqrt(float64(n)))
+		FFT(x)
+		if d := maxDiff(raw, x); d > 1e-12 {
+			t.Errorf("n=%d: FFTUnnormalized scaled by 1/sqrt(n) differs from FFT by %g", n, d)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package fft
+
+import "math"
+
+// morletOmega0 is the Morlet centre frequency in radians per unit scale; 6
+// makes the wavelet nearly admissible without a correction term.
+const morletOmega0 = 6
+
+// CWTMorlet returns the continuous wavelet transform of signal with the
+// Morlet wavelet, one row of len(signal) complex coefficients per scale.
+// Scales are in samples; a scale s responds most to a period of about
+// 1.03*s samples. Following Torrence and Compo, each row is the inverse
+// transform of the signal spectrum times the wavelet spectrum at that scale,
+// normalized so that every scale has unit energy. Any length is accepted.
+func CWTMorlet(signal []float64, scales []float64) [][]Complex {
+	n := len(signal)
+	spec := make([]Complex, n)
+	for i, v := range signal {
+		spec[i] = Complex{v, 0}
+	}
+	rawTransform(spec)
+
+	out := make([][]Complex, len(scales))
+	for j, s := range scales {
+		norm := math.Sqrt(2*math.Pi*s) * math.Pow(math.Pi, -0.25) / float64(n)
+		row := make([]Complex, n)
+		// the analytic wavelet has no support at negative frequencies
+		for k := 1; 2*k <= n; k++ {
+			omega := 2 * math.Pi * float64(k) / float64(n)
+			d := s*omega - morletOmega0
+			row[k] = spec[k].MulScalar(norm * math.Exp(-d*d/2))
+		}
+		rawInverseTransform(row)
+		out[j] = row
+	}
+	return out
+}
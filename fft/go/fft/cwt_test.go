@@ -0,0 +1,33 @@
+package fft
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCWTMorletPeaksAtToneScale(t *testing.T) {
+	const n = 1024
+	scales := make([]float64, 41)
+	for j := range scales {
+		scales[j] = 4 * math.Pow(2, float64(j)/10)
+	}
+	for _, period := range []float64{8, 16, 32} {
+		signal := make([]float64, n)
+		for i := range signal {
+			signal[i] = math.Cos(2 * math.Pi * float64(i) / period)
+		}
+		rows := CWTMorlet(signal, scales)
+
+		// the tone fills the signal, so every column sees the same
+		// magnitude; read the middle one
+		mags := make([]Complex, len(rows))
+		for j, row := range rows {
+			mags[j] = row[n/2]
+		}
+		best := scales[argmax(mags)]
+		want := period / 1.03
+		if math.Abs(best-want) > 0.05*want {
+			t.Errorf("period %g: peak at scale %.2f, want about %.2f", period, best, want)
+		}
+	}
+}
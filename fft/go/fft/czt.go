@@ -0,0 +1,45 @@
+package fft
+
+import "math/cmplx"
+
+// CZT evaluates the z-transform of signal at the m points a*w^-k,
+// X[k] = sum x[j] * a^-j * w^(j*k), using Bluestein's convolution. It is not
+// normalized: with a = 1 and w = exp(-2*pi*i/n) it equals the raw DFT, that is
+// sqrt(n) times FFT.
+func CZT(signal []Complex, m int, w Complex, a Complex) []Complex {
+	n := len(signal)
+	if n == 0 || m <= 0 {
+		return make([]Complex, max(m, 0))
+	}
+	wc, ac := w.ToC128(), a.ToC128()
+	// chirp(k) = w^(k*k/2)
+	chirp := func(k int) complex128 {
+		return cmplx.Pow(wc, complex(float64(k)*float64(k)/2, 0))
+	}
+
+	l := NextPow2(n + m - 1)
+	y := make([]Complex, l)
+	for j := range n {
+		y[j] = FromC128(signal[j].ToC128() * cmplx.Pow(ac, complex(-float64(j), 0)) * chirp(j))
+	}
+	v := make([]Complex, l)
+	for k := range m {
+		v[k] = FromC128(1 / chirp(k))
+	}
+	for j := 1; j < n; j++ {
+		v[l-j] = FromC128(1 / chirp(j))
+	}
+
+	fftCore(y, false)
+	fftCore(v, false)
+	for i := range l {
+		y[i] = y[i].Mul(v[i])
+	}
+	fftCore(y, true)
+
+	out := make([]Complex, m)
+	for k := range m {
+		out[k] = FromC128(y[k].ToC128() * chirp(k) / complex(float64(l), 0))
+	}
+	return out
+}
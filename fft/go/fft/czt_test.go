@@ -0,0 +1,36 @@
+package fft
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCZTReducesToDFT(t *testing.T) {
+	for _, n := range []int{1, 5, 16, 100} {
+		x := randomSignal(n, int64(n))
+		want := DFT(x)
+		ScaleInPlace(want, math.Sqrt(float64(n)))
+		got := CZT(x, n, FromPolar(1, -2*math.Pi/float64(n)), Complex{1, 0})
+		if d := maxDiff(got, want); d > 1e-8 {
+			t.Errorf("n=%d: CZT differs from the raw DFT by %g", n, d)
+		}
+	}
+}
+
+func TestCZTZoom(t *testing.T) {
+	// m points between bins 10 and 11 of a 64-point DFT, against direct sums
+	const n, m = 64, 9
+	x := randomSignal(n, 1)
+	step := 2 * math.Pi / n / (m - 1)
+	a := FromPolar(1, 10*2*math.Pi/n)
+	got := CZT(x, m, FromPolar(1, -step), a)
+	for k := range m {
+		var want Complex
+		for j, v := range x {
+			want = want.Add(v.Mul(FromPolar(1, -float64(j)*(10*2*math.Pi/n+float64(k)*step))))
+		}
+		if !got[k].ApproxEqual(want, 1e-9) {
+			t.Errorf("point %d = %v, want %v", k, got[k], want)
+		}
+	}
+}
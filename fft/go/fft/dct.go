@@ -0,0 +1,69 @@
+package fft
+
+import "math"
+
+// DCT computes the unnormalized type-II transform
+// X[k] = sum x[j]*cos(pi*k*(2j+1)/(2n)) from an FFT of the even extension of
+// signal.
+func DCT(signal []float64) []float64 {
+	n := len(signal)
+	y := make([]Complex, 2*n)
+	for j, v := range signal {
+		y[j] = Complex{v, 0}
+		y[2*n-1-j] = Complex{v, 0}
+	}
+	rawTransform(y)
+
+	out := make([]float64, n)
+	for k := range n {
+		ang := -math.Pi * float64(k) / float64(2*n)
+		out[k] = 0.5 * y[k].Mul(Complex{math.Cos(ang), math.Sin(ang)}).Real
+	}
+	return out
+}
+
+// IDCT inverts DCT, so IDCT(DCT(x)) recovers x.
+func IDCT(coeffs []float64) []float64 {
+	n := len(coeffs)
+	y := make([]Complex, 2*n)
+	for k, v := range coeffs {
+		ang := math.Pi * float64(k) / float64(2*n)
+		y[k] = Complex{math.Cos(ang), math.Sin(ang)}.MulScalar(2 * v)
+		if k > 0 {
+			y[2*n-k] = y[k].Conj()
+		}
+	}
+	rawInverseTransform(y)
+
+	out := make([]float64, n)
+	for j := range n {
+		out[j] = y[j].Real / float64(2*n)
+	}
+	return out
+}
+
+// rawTransform is the unnormalized forward DFT for any length, using
+// Bluestein when the length is not a power of two.
+func rawTransform(arr []Complex) {
+	n := len(arr)
+	if n == 0 {
+		return
+	}
+	if IsPow2(n) {
+		fftCore(arr, false)
+	} else {
+		bluestein(arr)
+	}
+}
+
+// rawInverseTransform is the unnormalized inverse of rawTransform, computed as
+// conj(DFT(conj(arr))).
+func rawInverseTransform(arr []Complex) {
+	for i := range arr {
+		arr[i] = arr[i].Conj()
+	}
+	rawTransform(arr)
+	for i := range arr {
+		arr[i] = arr[i].Conj()
+	}
+}
@@ -0,0 +1,39 @@
+package fft
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func randomReal(n int, seed int64) []float64 {
+	r := rand.New(rand.NewSource(seed))
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = 2*r.Float64() - 1
+	}
+	return x
+}
+
+func TestDCTMatchesSummation(t *testing.T) {
+	for _, n := range []int{4, 8} {
+		x := randomReal(n, int64(n))
+		got := DCT(x)
+		for k := range n {
+			want := 0.0
+			for j, v := range x {
+				want += v * math.Cos(math.Pi*float64(k*(2*j+1))/float64(2*n))
+			}
+			if math.Abs(got[k]-want) > 1e-12 {
+				t.Errorf("n=%d: DCT[%d] = %g, want %g", n, k, got[k], want)
+			}
+		}
+
+		back := IDCT(got)
+		for j := range x {
+			if math.Abs(back[j]-x[j]) > 1e-12 {
+				t.Errorf("n=%d: IDCT(DCT(x))[%d] = %g, want %g", n, j, back[j], x[j])
+			}
+		}
+	}
+}
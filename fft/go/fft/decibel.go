@@ -0,0 +1,23 @@
+package fft
+
+import "math"
+
+// ToDecibels returns 20*log10(m/ref) for each magnitude. A ref <= 0 uses the
+// largest magnitude, so the peak sits at 0 dB. Ratios below Epsilon are
+// clamped to it, so a zero magnitude maps to -240 dB rather than -Inf.
+func ToDecibels(magnitudes []float64, ref float64) []float64 {
+	if ref <= 0 {
+		ref = 0
+		for _, m := range magnitudes {
+			ref = max(ref, m)
+		}
+		if ref == 0 {
+			ref = 1
+		}
+	}
+	out := make([]float64, len(magnitudes))
+	for i, m := range magnitudes {
+		out[i] = 20 * math.Log10(max(m/ref, Epsilon))
+	}
+	return out
+}
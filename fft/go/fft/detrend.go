@@ -0,0 +1,44 @@
+package fft
+
+// DetrendMode selects what Detrend removes: the mean, or the least-squares
+// line through the samples.
+type DetrendMode int
+
+const (
+	DetrendConstant DetrendMode = iota
+	DetrendLinear
+)
+
+// Detrend returns a copy of signal with its trend removed; signal is left
+// untouched.
+func Detrend(signal []float64, mode DetrendMode) []float64 {
+	n := len(signal)
+	out := make([]float64, n)
+	if n == 0 {
+		return out
+	}
+
+	mean := 0.0
+	for _, v := range signal {
+		mean += v
+	}
+	mean /= float64(n)
+
+	// fit v = mean + slope*(i - mid); the slope is zero for constant mode
+	// and for a single sample
+	mid := float64(n-1) / 2
+	slope := 0.0
+	if mode == DetrendLinear && n > 1 {
+		var sxy, sxx float64
+		for i, v := range signal {
+			x := float64(i) - mid
+			sxy += x * (v - mean)
+			sxx += x * x
+		}
+		slope = sxy / sxx
+	}
+	for i, v := range signal {
+		out[i] = v - mean - slope*(float64(i)-mid)
+	}
+	return out
+}
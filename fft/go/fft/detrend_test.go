@@ -0,0 +1,40 @@
+package fft
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDetrendLinearRemovesRamp(t *testing.T) {
+	const n = 256
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = 3 + 0.05*float64(i) + math.Cos(2*math.Pi*20*float64(i)/n)
+	}
+	low := func(signal []float64) float64 {
+		spec := make([]Complex, n)
+		for i, v := range signal {
+			spec[i] = Complex{v, 0}
+		}
+		FFT(spec)
+		return Energy(spec[:4]) + Energy(spec[n-3:])
+	}
+	before, after := low(x), low(Detrend(x, DetrendLinear))
+	if after > 1e-6*before {
+		t.Errorf("low-bin energy %g before and %g after linear detrending", before, after)
+	}
+}
+
+func TestDetrendConstantRemovesMean(t *testing.T) {
+	x := []float64{1, 2, 3, 10}
+	got := Detrend(x, DetrendConstant)
+	want := []float64{-3, -2, -1, 6}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-15 {
+			t.Fatalf("Detrend(%v, DetrendConstant) = %v, want %v", x, got, want)
+		}
+	}
+	if x[3] != 10 {
+		t.Error("Detrend modified its input")
+	}
+}
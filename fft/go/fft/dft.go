@@ -0,0 +1,55 @@
+package fft
+
+import (
+	"fmt"
+	"math"
+)
+
+// DFT is the O(n^2) reference transform; it leaves arr untouched and uses the
+// same 1/sqrt(n) normalization as FFT.
+func DFT(arr []Complex) []Complex {
+	n := len(arr)
+	out := make([]Complex, n)
+	if n == 0 {
+		return out
+	}
+	factor := 1.0 / math.Sqrt(float64(n))
+	for k := range n {
+		var sum Complex
+		for j := range n {
+			ang := -2 * math.Pi * float64(j*k%n) / float64(n)
+			sum = sum.Add(arr[j].Mul(Complex{math.Cos(ang), math.Sin(ang)}))
+		}
+		out[k] = sum.MulScalar(factor)
+	}
+	return out
+}
+
+// DFTMatrix returns the n x n matrix with entries e^(-2*pi*i*j*k/n)/sqrt(n),
+// so MatVec(DFTMatrix(len(v)), v) equals the FFT of v.
+func DFTMatrix(n int) [][]Complex {
+	m := make([][]Complex, max(n, 0))
+	factor := 1.0 / math.Sqrt(float64(n))
+	for j := range m {
+		m[j] = make([]Complex, n)
+		for k := range m[j] {
+			ang := -2 * math.Pi * float64(j*k%n) / float64(n)
+			m[j][k] = FromPolar(factor, ang)
+		}
+	}
+	return m
+}
+
+// MatVec returns the product of the matrix m and the column vector v.
+func MatVec(m [][]Complex, v []Complex) ([]Complex, error) {
+	out := make([]Complex, len(m))
+	for j, row := range m {
+		if len(row) != len(v) {
+			return nil, fmt.Errorf("fft: %w: row %d has length %d, vector has %d", ErrLengthMismatch, j, len(row), len(v))
+		}
+		for k, c := range row {
+			out[j] = out[j].Add(c.Mul(v[k]))
+		}
+	}
+	return out, nil
+}
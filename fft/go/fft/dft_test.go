@@ -0,0 +1,60 @@
+package fft
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestFFTMatchesDFT(t *testing.T) {
+	for _, n := range []int{1, 2, 4, 8, 16} {
+		x := randomSignal(n, int64(n))
+		want := DFT(x)
+		FFT(x)
+		if d := maxDiff(x, want); d > 1e-12 {
+			t.Errorf("n=%d: FFT differs from DFT by %g", n, d)
+		}
+	}
+}
+
+func TestDFTMatrixMatchesFFT(t *testing.T) {
+	for _, n := range []int{1, 2, 4, 8, 16} {
+		x := randomSignal(n, int64(n))
+		got, err := MatVec(DFTMatrix(n), x)
+		if err != nil {
+			t.Fatalf("n=%d: %v", n, err)
+		}
+		FFT(x)
+		if d := maxDiff(got, x); d > 1e-12 {
+			t.Errorf("n=%d: DFTMatrix product differs from FFT by %g", n, d)
+		}
+	}
+	if m := DFTMatrix(0); len(m) != 0 {
+		t.Errorf("DFTMatrix(0) has %d rows, want 0", len(m))
+	}
+	if _, err := MatVec(DFTMatrix(4), make([]Complex, 3)); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("short vector: err = %v, want ErrLengthMismatch", err)
+	}
+}
+
+// BenchmarkDFTvsFFT times the O(n^2) reference DFT against FFT at the same
+// sizes; the ratio between matching sub-benchmarks shows where the FFT's
+// recursion overhead stops mattering.
+func BenchmarkDFTvsFFT(b *testing.B) {
+	for lg := 2; lg <= 12; lg += 2 {
+		n := 1 << lg
+		src := randomSignal(n, 1)
+		b.Run(fmt.Sprintf("DFT/n=2^%d", lg), func(b *testing.B) {
+			for b.Loop() {
+				DFT(src) // DFT allocates its output and leaves src alone
+			}
+		})
+		b.Run(fmt.Sprintf("FFT/n=2^%d", lg), func(b *testing.B) {
+			arr := make([]Complex, n)
+			for b.Loop() {
+				copy(arr, src)
+				FFT(arr)
+			}
+		})
+	}
+}
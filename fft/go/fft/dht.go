@@ -0,0 +1,21 @@
+package fft
+
+import "math"
+
+// DHT computes the discrete Hartley transform with kernel cos+sin and the
+// same 1/sqrt(n) scaling as FFT, which makes it its own inverse.
+func DHT(signal []float64) []float64 {
+	n := len(signal)
+	spec := make([]Complex, n)
+	for i, v := range signal {
+		spec[i] = Complex{v, 0}
+	}
+	rawTransform(spec)
+
+	out := make([]float64, n)
+	factor := 1 / math.Sqrt(float64(n))
+	for k, c := range spec {
+		out[k] = (c.Real - c.Imag) * factor
+	}
+	return out
+}
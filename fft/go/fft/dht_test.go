@@ -0,0 +1,33 @@
+package fft
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDHT(t *testing.T) {
+	for _, n := range []int{1, 5, 8, 64} {
+		x := randomReal(n, int64(n))
+		h := DHT(x)
+
+		// with a real input, cas = cos+sin picks Re(X) - Im(X) from the
+		// orthonormal spectrum, since the DFT kernel is cos - i*sin
+		spec := make([]Complex, n)
+		for i, v := range x {
+			spec[i] = Complex{v, 0}
+		}
+		for k, c := range DFT(spec) {
+			if want := c.Real - c.Imag; math.Abs(h[k]-want) > 1e-12 {
+				t.Fatalf("n=%d: DHT[%d] = %g, want Re-Im of the spectrum %g", n, k, h[k], want)
+			}
+		}
+
+		// the 1/sqrt(n) scaling makes DHT its own inverse
+		back := DHT(h)
+		for i := range x {
+			if math.Abs(back[i]-x[i]) > 1e-12 {
+				t.Fatalf("n=%d: DHT(DHT(x))[%d] = %g, want %g", n, i, back[i], x[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package fft
+
+import "fmt"
+
+// FFTDIF is the decimation-in-frequency counterpart of FFTIterative: it
+// takes input in natural order and leaves the spectrum in bit-reversed
+// order. Call BitReverse on the result to get the layout FFT produces.
+// len(arr) must be a power of two; other lengths return ErrNotPowerOfTwo
+// and leave arr untouched.
+func FFTDIF(arr []Complex) error {
+	n := len(arr)
+	if n <= 1 {
+		return nil
+	}
+	if !IsPow2(n) {
+		return fmt.Errorf("fft: length %d is %w", n, ErrNotPowerOfTwo)
+	}
+	tw := twiddles(n, -1)
+	for size := n; size >= 2; size >>= 1 {
+		half := size / 2
+		step := n / size
+		for start := 0; start < n; start += size {
+			for k := range half {
+				p := arr[start+k]
+				q := arr[start+k+half]
+				arr[start+k] = p.Add(q)
+				arr[start+k+half] = p.Sub(q).Mul(tw[k*step])
+			}
+		}
+	}
+	normalize(arr)
+	return nil
+}
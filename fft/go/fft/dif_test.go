@@ -0,0 +1,32 @@
+package fft
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFFTDIFMatchesFFTAfterReordering(t *testing.T) {
+	for _, n := range []int{2, 4, 8, 64, 1024} {
+		x := randomSignal(n, int64(n))
+		want := Clone(x)
+		FFT(want)
+		if err := FFTDIF(x); err != nil {
+			t.Fatalf("n=%d: %v", n, err)
+		}
+		BitReverse(x)
+		if d := maxDiff(x, want); d > 1e-12 {
+			t.Errorf("n=%d: BitReverse(FFTDIF(x)) differs from FFT by %g", n, d)
+		}
+	}
+}
+
+func TestFFTDIFRejectsNonPowerOfTwo(t *testing.T) {
+	x := randomSignal(12, 1)
+	want := Clone(x)
+	if err := FFTDIF(x); !errors.Is(err, ErrNotPowerOfTwo) {
+		t.Errorf("n=12: err = %v, want ErrNotPowerOfTwo", err)
+	}
+	if d := maxDiff(x, want); d != 0 {
+		t.Error("rejected input was modified")
+	}
+}
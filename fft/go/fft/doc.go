@@ -0,0 +1,37 @@
+// Package fft implements fast Fourier transforms and the spectral tools
+// built on them.
+//
+// FFT and IFFT transform a power-of-two []Complex in place with orthonormal
+// 1/sqrt(n) scaling:
+//
+//	x := []fft.Complex{{1, 0}, {2, 0}, {3, 0}, {4, 0}}
+//	fft.FFT(x)
+//	fmt.Println(x) // [5 -1+1i -1 -1-1i]
+//
+// Spectra are in natural order, as in NumPy: bin k of an n-point transform
+// holds frequency k/n cycles per sample for k < n/2 and (k-n)/n from n/2 on,
+// so DC comes first, then the positive frequencies ascending, then the
+// negative ones from most negative up to -1/n. The Nyquist bin of an even n
+// is counted as negative. A complex exponential exp(2*pi*i*k*t/n) thus
+// lands entirely in bin k mod n, and FreqBins gives each bin's frequency.
+// FFTShift moves DC to the middle.
+//
+// Complex is a plain value type with the usual arithmetic:
+//
+//	fmt.Println(fft.Complex{Real: 3, Imag: 4}.Abs()) // 5
+//
+// Convolve and friends accept any length and handle padding themselves:
+//
+//	a := []fft.Complex{{1, 0}, {1, 0}}
+//	b := []fft.Complex{{1, 0}, {2, 0}, {3, 0}}
+//	fmt.Println(fft.Convolve(a, b)) // [1 3 5 3], up to rounding
+//
+// Every function may be called from many goroutines at once on separate
+// slices: the shared scratch pools and twiddle caches are synchronized
+// internally, and nothing else is shared between calls. A Plan can be
+// shared too, though OpCounts then reports whichever Execute finished last.
+// Streamer and SlidingDFT carry state and need one value per goroutine.
+// Package settings such as DetectRealInput, DetectZeroInput,
+// ParallelThreshold and Epsilon are read without locking: set them before
+// starting transforms and leave them alone while any are running.
+package fft
@@ -0,0 +1,14 @@
+package fft
+
+import "errors"
+
+// Errors returned, wrapped with context, by the validating entry points;
+// test for them with errors.Is. Empty input to a transform is a no-op rather
+// than ErrEmptyInput, which is reserved for functions that cannot produce a
+// result without data.
+var (
+	ErrNotPowerOfTwo  = errors.New("not a power of two")
+	ErrEmptyInput     = errors.New("empty input")
+	ErrLengthMismatch = errors.New("length mismatch")
+	ErrNonFinite      = errors.New("non-finite value")
+)
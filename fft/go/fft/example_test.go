@@ -0,0 +1,58 @@
+package fft_test
+
+import (
+	"fmt"
+	"math"
+
+	"main/fft"
+)
+
+// round snaps both parts of c to 1e-9 so rounding noise doesn't reach the
+// printed output.
+func round(c fft.Complex) fft.Complex {
+	r := func(v float64) float64 { return math.Round(v*1e9)/1e9 + 0 }
+	return fft.Complex{Real: r(c.Real), Imag: r(c.Imag)}
+}
+
+func ExampleFFT() {
+	x := []fft.Complex{{Real: 1}, {Real: 2}, {Real: 3}, {Real: 4}}
+	fft.FFT(x) // orthonormal: every bin is scaled by 1/sqrt(4)
+	for _, c := range x {
+		fmt.Println(round(c))
+	}
+	// Output:
+	// 5
+	// -1+1i
+	// -1
+	// -1-1i
+}
+
+func ExampleIFFT() {
+	x := []fft.Complex{{Real: 1}, {Real: 2}, {Real: 3}, {Real: 4}}
+	fft.FFT(x)
+	fft.IFFT(x)
+	for _, c := range x {
+		fmt.Println(round(c))
+	}
+	// Output:
+	// 1
+	// 2
+	// 3
+	// 4
+}
+
+func ExampleComplex_Abs() {
+	c := fft.Complex{Real: 3, Imag: 4}
+	fmt.Println(c.Abs())
+	// Output: 5
+}
+
+func ExampleConvolve() {
+	a := []fft.Complex{{Real: 1}, {Real: 2}, {Real: 3}}
+	b := []fft.Complex{{Real: 0}, {Real: 1}, {Real: 0.5}}
+	for _, c := range fft.Convolve(a, b) {
+		fmt.Print(round(c), " ")
+	}
+	fmt.Println()
+	// Output: 0 1 2.5 4 1.5
+}
@@ -0,0 +1,47 @@
+package fft
+
+import "fmt"
+
+// FFT2D transforms a rectangular grid in place, rows first and then
+// columns. Both dimensions must be powers of two.
+func FFT2D(data [][]Complex) error {
+	return transform2D(data, FFT[float64])
+}
+
+func IFFT2D(data [][]Complex) error {
+	return transform2D(data, IFFT[float64])
+}
+
+func transform2D(data [][]Complex, transform func([]Complex)) error {
+	rows := len(data)
+	if rows == 0 {
+		return nil
+	}
+	cols := len(data[0])
+	for i, row := range data {
+		if len(row) != cols {
+			return fmt.Errorf("fft: %w: row %d has length %d, want %d", ErrLengthMismatch, i, len(row), cols)
+		}
+	}
+	if !IsPow2(rows) {
+		return fmt.Errorf("fft: row count %d is %w", rows, ErrNotPowerOfTwo)
+	}
+	if !IsPow2(cols) {
+		return fmt.Errorf("fft: column count %d is %w", cols, ErrNotPowerOfTwo)
+	}
+
+	for _, row := range data {
+		transform(row)
+	}
+	col := make([]Complex, rows)
+	for j := range cols {
+		for i := range rows {
+			col[i] = data[i][j]
+		}
+		transform(col)
+		for i := range rows {
+			data[i][j] = col[i]
+		}
+	}
+	return nil
+}
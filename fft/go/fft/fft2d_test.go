@@ -0,0 +1,76 @@
+package fft
+
+import (
+	"errors"
+	"testing"
+)
+
+func randomGrid(rows, cols int) [][]Complex {
+	grid := make([][]Complex, rows)
+	for i := range grid {
+		grid[i] = randomSignal(cols, int64(i))
+	}
+	return grid
+}
+
+func cloneGrid(grid [][]Complex) [][]Complex {
+	out := make([][]Complex, len(grid))
+	for i, row := range grid {
+		out[i] = Clone(row)
+	}
+	return out
+}
+
+func TestFFT2DRoundTrip(t *testing.T) {
+	for _, size := range [][2]int{{4, 4}, {2, 8}, {256, 512}} {
+		grid := randomGrid(size[0], size[1])
+		want := cloneGrid(grid)
+		if err := FFT2D(grid); err != nil {
+			t.Fatal(err)
+		}
+		if err := IFFT2D(grid); err != nil {
+			t.Fatal(err)
+		}
+		for i := range grid {
+			if d := maxDiff(grid[i], want[i]); d > 1e-9 {
+				t.Fatalf("%dx%d: row %d differs by %g after the round trip", size[0], size[1], i, d)
+			}
+		}
+	}
+}
+
+func TestFFT2DSeparable(t *testing.T) {
+	// a grid of equal rows transforms to a single non-zero row
+	grid := make([][]Complex, 4)
+	row := randomSignal(4, 1)
+	for i := range grid {
+		grid[i] = Clone(row)
+	}
+	if err := FFT2D(grid); err != nil {
+		t.Fatal(err)
+	}
+	FFT(row)
+	ScaleInPlace(row, 2) // sqrt(4) rows summed into the DC row
+	if d := maxDiff(grid[0], row); d > 1e-12 {
+		t.Errorf("DC row differs by %g", d)
+	}
+	for i := 1; i < 4; i++ {
+		if e := Energy(grid[i]); e > 1e-24 {
+			t.Errorf("row %d has energy %g, want 0", i, e)
+		}
+	}
+}
+
+func TestFFT2DValidation(t *testing.T) {
+	if err := FFT2D(randomGrid(3, 4)); !errors.Is(err, ErrNotPowerOfTwo) {
+		t.Errorf("3 rows: err = %v, want ErrNotPowerOfTwo", err)
+	}
+	if err := FFT2D(randomGrid(4, 6)); !errors.Is(err, ErrNotPowerOfTwo) {
+		t.Errorf("6 columns: err = %v, want ErrNotPowerOfTwo", err)
+	}
+	ragged := randomGrid(2, 4)
+	ragged[1] = ragged[1][:2]
+	if err := FFT2D(ragged); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("ragged grid: err = %v, want ErrLengthMismatch", err)
+	}
+}
@@ -0,0 +1,208 @@
+package fft
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// randomSignal returns n complex samples with components uniform in [-1, 1).
+func randomSignal(n int, seed int64) []Complex {
+	r := rand.New(rand.NewSource(seed))
+	x := make([]Complex, n)
+	for i := range x {
+		x[i] = Complex{2*r.Float64() - 1, 2*r.Float64() - 1}
+	}
+	return x
+}
+
+// maxDiff returns the largest |a[i]-b[i]|; a and b must have the same length.
+func maxDiff(a, b []Complex) float64 {
+	d := 0.0
+	for i := range a {
+		d = max(d, a[i].Sub(b[i]).Abs())
+	}
+	return d
+}
+
+func TestIFFTRoundTrip(t *testing.T) {
+	for _, n := range []int{1, 2, 4, 8, 64, 1024} {
+		x := randomSignal(n, int64(n))
+		y := Clone(x)
+		FFT(y)
+		IFFT(y)
+		if d := maxDiff(x, y); d > 1e-9 {
+			t.Errorf("n=%d: IFFT(FFT(x)) differs from x by %g", n, d)
+		}
+	}
+}
+
+// TestRoundTripError checks the bound quoted in the IFFT doc comment. The
+// measured error stays below about 0.7*log2(n) machine epsilons, so the
+// bound leaves roughly 3x headroom.
+func TestRoundTripError(t *testing.T) {
+	const eps = 0x1p-52
+	r := rand.New(rand.NewSource(1))
+	for lg := 1; lg <= 20; lg++ {
+		n := 1 << lg
+		x := make([]Complex, n)
+		for i := range x {
+			x[i] = FromPolar(1, 2*math.Pi*r.Float64())
+		}
+		y := Clone(x)
+		FFT(y)
+		IFFT(y)
+		if d, tol := maxDiff(x, y), 2*float64(lg)*eps; d > tol {
+			t.Errorf("n=2^%d: IFFT(FFT(x)) differs from x by %g, want <= %g", lg, d, tol)
+		}
+	}
+}
+
+func TestFFT32MatchesFFT(t *testing.T) {
+	for _, n := range []int{2, 16, 1024} {
+		x := randomSignal(n, int64(n))
+		x32 := make([]Complex32, n)
+		for i, c := range x {
+			x32[i] = Complex32{float32(c.Real), float32(c.Imag)}
+		}
+		FFT(x)
+		FFT32(x32)
+		got := make([]Complex, n)
+		for i, c := range x32 {
+			got[i] = Complex{float64(c.Real), float64(c.Imag)}
+		}
+		if d := maxDiff(got, x); d > 1e-4 {
+			t.Errorf("n=%d: FFT32 differs from FFT by %g", n, d)
+		}
+	}
+}
+
+func TestFFTIntoDoesNotAllocate(t *testing.T) {
+	x := randomSignal(1024, 1)
+	want := Clone(x)
+	FFT(want)
+	scratch := make([]Complex, len(x))
+	if err := FFTInto(x, scratch); err != nil {
+		t.Fatal(err)
+	}
+	if d := maxDiff(x, want); d > 1e-12 {
+		t.Errorf("FFTInto differs from FFT by %g", d)
+	}
+	if allocs := testing.AllocsPerRun(100, func() { FFTInto(x, scratch) }); allocs != 0 {
+		t.Errorf("FFTInto allocates %v times per call, want 0", allocs)
+	}
+	if err := FFTInto(x, scratch[:10]); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("short scratch: err = %v, want ErrLengthMismatch", err)
+	}
+}
+
+// TestConcurrentFFT transforms independent slices from many goroutines at
+// once; under -race it catches unsynchronized sharing in the scratch pools,
+// twiddle caches or a shared Plan.
+func TestConcurrentFFT(t *testing.T) {
+	// 8, 16 and 32 have no baked twiddles. want comes from DFT, and the
+	// cache is emptied of what earlier tests put there, so it is the
+	// goroutines below that race to fill it.
+	sizes := []int{8, 16, 32, 64, 256, 2048}
+	want := make(map[int][]Complex)
+	for _, n := range sizes {
+		want[n] = DFT(randomSignal(n, int64(n)))
+	}
+	for i := range twiddleCache {
+		twiddleCache[i].Store(nil)
+	}
+	plan, err := NewPlan(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for g := range 16 {
+		t.Run(fmt.Sprint(g), func(t *testing.T) {
+			t.Parallel()
+			for i := range 20 {
+				n := sizes[(g+i)%len(sizes)]
+				x := randomSignal(n, int64(n))
+				if g%4 == 0 && n == 256 {
+					plan.FFT(x)
+				} else {
+					FFT(x)
+				}
+				if d := maxDiff(x, want[n]); d > 1e-12 {
+					t.Errorf("n=%d: concurrent FFT differs by %g", n, d)
+				}
+				// round-trip in float32, which shares the scratch pools
+				src := randomSignal(n, int64(g))
+				x32 := make([]Complex32, n)
+				for j, c := range src {
+					x32[j] = Complex32{float32(c.Real), float32(c.Imag)}
+				}
+				FFT32(x32)
+				IFFT(x32)
+				for j, c := range x32 {
+					if e := (Complex{float64(c.Real), float64(c.Imag)}).Sub(src[j]).Abs(); e > 1e-5 {
+						t.Fatalf("n=%d: concurrent float32 round trip off by %g at %d", n, e, j)
+					}
+				}
+			}
+		})
+	}
+}
+
+// namedTransform is one contender in benchCompare.
+type namedTransform struct {
+	name      string
+	transform func([]Complex)
+}
+
+// benchCompare runs every transform at every length 2^lg as sub-benchmarks
+// named "name/n=2^lg", copying the same input back before each run.
+func benchCompare(b *testing.B, lgs []int, transforms ...namedTransform) {
+	for _, lg := range lgs {
+		n := 1 << lg
+		src := randomSignal(n, 1)
+		arr := make([]Complex, n)
+		for _, tr := range transforms {
+			b.Run(fmt.Sprintf("%s/n=2^%d", tr.name, lg), func(b *testing.B) {
+				b.ReportAllocs()
+				for b.Loop() {
+					copy(arr, src)
+					tr.transform(arr)
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkFFT(b *testing.B) {
+	for lg := 10; lg <= 20; lg++ {
+		n := 1 << lg
+		b.Run(fmt.Sprintf("n=2^%d", lg), func(b *testing.B) {
+			src := randomSignal(n, 1)
+			arr := make([]Complex, n)
+			b.ReportAllocs()
+			b.SetBytes(int64(n) * 16)
+			for b.Loop() {
+				copy(arr, src) // FFT is in place, so start each run from the same input
+				FFT(arr)
+			}
+		})
+	}
+}
+
+// BenchmarkFFTMixedPrecisionParallel runs float64 and float32 transforms of
+// the same length from every goroutine at once, so a scratch pool shared by
+// both precisions would keep handing out buffers of the wrong type.
+func BenchmarkFFTMixedPrecisionParallel(b *testing.B) {
+	const n = 1 << 12
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		x := randomSignal(n, 1)
+		x32 := make([]Complex32, n)
+		for pb.Next() {
+			FFT(x)
+			FFT32(x32)
+		}
+	})
+}
@@ -0,0 +1,25 @@
+package fft
+
+// BandPass zeroes every bin of spectrum outside [low, high], where bins are
+// counted from DC and bin n-k mirrors bin k, so the Hermitian symmetry of a
+// real signal's spectrum survives and its inverse stays real.
+func BandPass(spectrum []Complex, low, high int) {
+	band(spectrum, low, high, false)
+}
+
+// BandStop zeroes the bins inside [low, high] and their mirrors, the
+// complement of BandPass.
+func BandStop(spectrum []Complex, low, high int) {
+	band(spectrum, low, high, true)
+}
+
+func band(spectrum []Complex, low, high int, stop bool) {
+	n := len(spectrum)
+	Apply(spectrum, func(k int, c Complex) Complex {
+		f := min(k, n-k)
+		if (low <= f && f <= high) == stop {
+			return Complex{}
+		}
+		return c
+	})
+}
@@ -0,0 +1,35 @@
+package fft
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBandPassRecoversTone(t *testing.T) {
+	const n = 256
+	tone := func(k int, i int) float64 { return math.Cos(2 * math.Pi * float64(k*i) / n) }
+	x := make([]Complex, n)
+	want := make([]Complex, n)
+	rest := make([]Complex, n)
+	for i := range x {
+		x[i] = Complex{tone(10, i) + 0.5*tone(60, i), 0}
+		want[i] = Complex{tone(10, i), 0}
+		rest[i] = Complex{0.5 * tone(60, i), 0}
+	}
+
+	pass := Clone(x)
+	FFT(pass)
+	BandPass(pass, 8, 12)
+	IFFT(pass)
+	if d := maxDiff(pass, want); d > 1e-12 {
+		t.Errorf("BandPass around bin 10 differs from the bin-10 tone by %g", d)
+	}
+
+	stop := Clone(x)
+	FFT(stop)
+	BandStop(stop, 8, 12)
+	IFFT(stop)
+	if d := maxDiff(stop, rest); d > 1e-12 {
+		t.Errorf("BandStop around bin 10 differs from the remaining tone by %g", d)
+	}
+}
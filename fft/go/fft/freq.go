@@ -0,0 +1,29 @@
+package fft
+
+// FreqBins returns the frequency of each of the n FFT bins for the given
+// sample rate, laid out like numpy.fft.fftfreq: non-negative frequencies
+// first, then the negative ones, with the Nyquist bin of an even n counted as
+// negative.
+func FreqBins(n int, sampleRate float64) []float64 {
+	out := make([]float64, max(n, 0))
+	for k := range out {
+		bin := k
+		if 2*k >= n {
+			bin = k - n
+		}
+		out[k] = float64(bin) * sampleRate / float64(n)
+	}
+	return out
+}
+
+// RFreqBins returns the n/2+1 non-negative frequencies matching RFFT.
+func RFreqBins(n int, sampleRate float64) []float64 {
+	if n <= 0 {
+		return []float64{}
+	}
+	out := make([]float64, n/2+1)
+	for k := range out {
+		out[k] = float64(k) * sampleRate / float64(n)
+	}
+	return out
+}
@@ -0,0 +1,67 @@
+//go:build ignore
+
+// gen_twiddles writes twiddles_gen.go, the forward twiddle tables baked in
+// for common sizes. It mirrors the quarter-wave symmetry of twiddles in
+// iterative.go so the baked values match computed ones exactly.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"math"
+	"os"
+	"strconv"
+)
+
+const minLog, maxLog = 6, 12 // 64 ... 4096
+
+func main() {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen_twiddles.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package fft\n\n")
+	fmt.Fprintf(&buf, "// bakedTwiddles holds twiddles(n, -1) for n = %d ... %d, indexed by\n", 1<<minLog, 1<<maxLog)
+	buf.WriteString("// log2(n).\n")
+	buf.WriteString("var bakedTwiddles = [...][]Complex{\n")
+	for lg := minLog; lg <= maxLog; lg++ {
+		fmt.Fprintf(&buf, "%d: {\n", lg)
+		for _, c := range twiddles(1 << lg) {
+			fmt.Fprintf(&buf, "{%s, %s},\n", formatFloat(c[0]), formatFloat(c[1]))
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("twiddles_gen.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func twiddles(n int) [][2]float64 {
+	tw := make([][2]float64, n/2)
+	q := n / 4
+	for k := range tw {
+		if k < q {
+			ang := -2 * math.Pi * float64(k) / float64(n)
+			tw[k] = [2]float64{math.Cos(ang), math.Sin(ang)}
+		} else {
+			t := tw[k-q]
+			tw[k] = [2]float64{t[1], -t[0]}
+		}
+	}
+	return tw
+}
+
+// formatFloat prints the shortest representation that parses back to v. A
+// -0 becomes the constant 0, the only difference from computed tables.
+func formatFloat(v float64) string {
+	if v == 0 {
+		return "0"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
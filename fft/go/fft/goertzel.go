@@ -0,0 +1,60 @@
+package fft
+
+import "math"
+
+// Goertzel computes bin targetBin of the n-point FFT of signal (normalized
+// like FFT) without transforming the whole signal. Samples past len(signal)
+// are treated as zero.
+func Goertzel(signal []float64, targetBin int, n int) Complex {
+	if n <= 0 {
+		return Complex{}
+	}
+	omega := 2 * math.Pi * float64(targetBin) / float64(n)
+	coeff := 2 * math.Cos(omega)
+	var s1, s2 float64
+	for j := range min(n, len(signal)) {
+		s1, s2 = signal[j]+coeff*s1-s2, s1
+	}
+	for range n - min(n, len(signal)) {
+		s1, s2 = coeff*s1-s2, s1
+	}
+	return goertzelBin(s1, s2, omega, n)
+}
+
+// GoertzelBank runs the Goertzel recurrence for every bin in a single pass
+// over signal, returning the bins in the order requested.
+func GoertzelBank(signal []float64, bins []int, n int) []Complex {
+	out := make([]Complex, len(bins))
+	if n <= 0 {
+		return out
+	}
+	omegas := make([]float64, len(bins))
+	coeffs := make([]float64, len(bins))
+	s1 := make([]float64, len(bins))
+	s2 := make([]float64, len(bins))
+	for b, bin := range bins {
+		omegas[b] = 2 * math.Pi * float64(bin) / float64(n)
+		coeffs[b] = 2 * math.Cos(omegas[b])
+	}
+	for j := range n {
+		x := 0.0
+		if j < len(signal) {
+			x = signal[j]
+		}
+		for b := range bins {
+			s1[b], s2[b] = x+coeffs[b]*s1[b]-s2[b], s1[b]
+		}
+	}
+	for b := range bins {
+		out[b] = goertzelBin(s1[b], s2[b], omegas[b], n)
+	}
+	return out
+}
+
+// goertzelBin finishes the recurrence with one extra zero-input step, then
+// y[n] = s[n] - exp(-i*omega)*s[n-1].
+func goertzelBin(s1, s2, omega float64, n int) Complex {
+	s := 2*math.Cos(omega)*s1 - s2
+	bin := Complex{s - math.Cos(omega)*s1, math.Sin(omega) * s1}
+	return bin.MulScalar(1 / math.Sqrt(float64(n)))
+}
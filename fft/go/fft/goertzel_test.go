@@ -0,0 +1,22 @@
+package fft
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGoertzelMatchesFFT(t *testing.T) {
+	const n, bin = 64, 5
+	signal := make([]float64, n)
+	spec := make([]Complex, n)
+	for i := range signal {
+		signal[i] = math.Sin(2*math.Pi*bin*float64(i)/n + 0.3)
+		spec[i] = Complex{signal[i], 0}
+	}
+	FFT(spec)
+	for _, k := range []int{bin, n - bin, 0, 9} {
+		if got := Goertzel(signal, k, n); !got.ApproxEqual(spec[k], 1e-9) {
+			t.Errorf("Goertzel bin %d = %v, want %v", k, got, spec[k])
+		}
+	}
+}
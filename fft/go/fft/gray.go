@@ -0,0 +1,52 @@
+package fft
+
+import (
+	"fmt"
+	"image"
+	"math"
+)
+
+// FromGray converts img to a grid for FFT2D, one row per pixel row, with
+// each intensity 0-255 as a real part. Both dimensions are zero-padded up to
+// the next power of two, so the grid can be larger than img; an empty image
+// gives an empty grid.
+func FromGray(img *image.Gray) [][]Complex {
+	b := img.Bounds()
+	if b.Empty() {
+		return [][]Complex{}
+	}
+	data := make([][]Complex, NextPow2(b.Dy()))
+	cols := NextPow2(b.Dx())
+	for y := range data {
+		data[y] = make([]Complex, cols)
+	}
+	for y := range b.Dy() {
+		for x := range b.Dx() {
+			data[y][x] = Complex{float64(img.GrayAt(b.Min.X+x, b.Min.Y+y).Y), 0}
+		}
+	}
+	return data
+}
+
+// ToGray maps the magnitude of each element of a rectangular grid to a pixel,
+// rounded and clamped to 0-255. The image has the grid's size, with its
+// origin at (0, 0); use SubImage to crop away padding added by FromGray.
+// Rows of differing lengths return ErrLengthMismatch.
+func ToGray(data [][]Complex) (*image.Gray, error) {
+	cols := 0
+	if len(data) > 0 {
+		cols = len(data[0])
+	}
+	for i, row := range data {
+		if len(row) != cols {
+			return nil, fmt.Errorf("fft: %w: row %d has length %d, want %d", ErrLengthMismatch, i, len(row), cols)
+		}
+	}
+	img := image.NewGray(image.Rect(0, 0, cols, len(data)))
+	for y, row := range data {
+		for x, c := range row {
+			img.Pix[y*img.Stride+x] = uint8(min(max(math.Round(c.Abs()), 0), 255))
+		}
+	}
+	return img, nil
+}
@@ -0,0 +1,63 @@
+package fft
+
+import (
+	"errors"
+	"image"
+	"testing"
+)
+
+func TestGrayRoundTrip(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 3, 5))
+	for i := range img.Pix {
+		img.Pix[i] = uint8(17 * i)
+	}
+	data := FromGray(img)
+	if len(data) != 8 || len(data[0]) != 4 {
+		t.Fatalf("FromGray grid is %dx%d, want 8x4", len(data), len(data[0]))
+	}
+	if err := FFT2D(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := IFFT2D(data); err != nil {
+		t.Fatal(err)
+	}
+	out, err := ToGray(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := out.SubImage(image.Rect(0, 0, 3, 5)).(*image.Gray); !sameGray(got, img) {
+		t.Errorf("round trip through FFT2D changed the pixels")
+	}
+}
+
+func sameGray(a, b *image.Gray) bool {
+	if a.Bounds().Size() != b.Bounds().Size() {
+		return false
+	}
+	for y := range a.Bounds().Dy() {
+		for x := range a.Bounds().Dx() {
+			pa := a.GrayAt(a.Bounds().Min.X+x, a.Bounds().Min.Y+y)
+			pb := b.GrayAt(b.Bounds().Min.X+x, b.Bounds().Min.Y+y)
+			if pa != pb {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestToGray(t *testing.T) {
+	img, err := ToGray([][]Complex{{{3, 4}, {-300, 0}}, {{0.4, 0}, {}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []uint8{5, 255, 0, 0}; string(img.Pix) != string(want) {
+		t.Errorf("pixels = %v, want %v", img.Pix, want)
+	}
+	if _, err := ToGray([][]Complex{make([]Complex, 4), make([]Complex, 3)}); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("ragged rows: err = %v, want ErrLengthMismatch", err)
+	}
+	if img, err := ToGray(nil); err != nil || !img.Bounds().Empty() {
+		t.Errorf("ToGray(nil) = %v, %v, want an empty image", img.Bounds(), err)
+	}
+}
@@ -0,0 +1,27 @@
+package fft
+
+// Hilbert returns the analytic signal of signal: its real part is signal
+// and its magnitude is the envelope. Any length is accepted.
+func Hilbert(signal []float64) []Complex {
+	n := len(signal)
+	spec := make([]Complex, n)
+	for i, v := range signal {
+		spec[i] = Complex{v, 0}
+	}
+	rawTransform(spec)
+
+	// keep DC (and Nyquist for even n), double positive and drop negative
+	// frequencies
+	for k := 1; k < n; k++ {
+		switch {
+		case 2*k < n:
+			spec[k] = spec[k].MulScalar(2)
+		case 2*k > n:
+			spec[k] = Complex{}
+		}
+	}
+
+	rawInverseTransform(spec)
+	scale(spec, 1/float64(n))
+	return spec
+}
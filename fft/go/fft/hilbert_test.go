@@ -0,0 +1,24 @@
+package fft
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHilbertEnvelopeOfCosine(t *testing.T) {
+	const n, amp = 256, 2.5
+	for _, cycles := range []float64{3, 17, 40} {
+		x := make([]float64, n)
+		for i := range x {
+			x[i] = amp * math.Cos(2*math.Pi*cycles*float64(i)/n)
+		}
+		for i, c := range Hilbert(x) {
+			if math.Abs(c.Real-x[i]) > 1e-12 {
+				t.Fatalf("cycles=%g: real part %g at %d, want the input %g", cycles, c.Real, i, x[i])
+			}
+			if math.Abs(c.Abs()-amp) > 1e-9 {
+				t.Fatalf("cycles=%g: envelope %g at %d, want %g", cycles, c.Abs(), i, amp)
+			}
+		}
+	}
+}
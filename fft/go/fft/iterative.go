@@ -0,0 +1,113 @@
+package fft
+
+import (
+	"fmt"
+	"math"
+)
+
+// FFTIterative is FFT computed in place by a bit-reversal pass followed by
+// log2(n) butterfly stages, allocating only the twiddle table. len(arr)
+// must be a power of two; other lengths return ErrNotPowerOfTwo and leave
+// arr untouched.
+func FFTIterative(arr []Complex) error {
+	n := len(arr)
+	if n <= 1 {
+		return nil
+	}
+	if !IsPow2(n) {
+		return fmt.Errorf("fft: length %d is %w", n, ErrNotPowerOfTwo)
+	}
+	BitReverse(arr)
+	butterflies(arr, twiddles(n, -1))
+	normalize(arr)
+	return nil
+}
+
+// BitReverse swaps each index with its reversal over log2(len(arr)) bits;
+// len(arr) must be a power of two.
+func BitReverse(arr []Complex) {
+	bitReverse(arr)
+}
+
+func bitReverse[E any](arr []E) {
+	n := len(arr)
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+		if i < j {
+			arr[i], arr[j] = arr[j], arr[i]
+		}
+	}
+}
+
+// BitReverseTable returns the permutation BitReverse applies for length n:
+// element i moves to index table[i]. n must be a power of two.
+func BitReverseTable(n int) []int {
+	table := make([]int, n)
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+		table[i] = j
+	}
+	return table
+}
+
+// permute applies a BitReverseTable permutation to arr in place.
+func permute(arr []Complex, table []int) {
+	for i, j := range table {
+		if i < j {
+			arr[i], arr[j] = arr[j], arr[i]
+		}
+	}
+}
+
+func twiddles(n int, sign float64) []Complex {
+	tw := make([]Complex, n/2)
+	q := n / 4
+	for k := range n / 2 {
+		if k < q || n%4 != 0 {
+			ang := sign * 2 * math.Pi * float64(k) / float64(n)
+			tw[k] = Complex{math.Cos(ang), math.Sin(ang)}
+		} else {
+			// W^(k+n/4) = W^k * W^(n/4), and W^(n/4) is sign*i.
+			t := tw[k-q]
+			tw[k] = Complex{-sign * t.Imag, sign * t.Real}
+		}
+	}
+	return tw
+}
+
+// butterflies expects arr in bit-reversed order; tw must hold the n/2
+// twiddles for len(arr), smaller stages stride through it.
+func butterflies(arr []Complex, tw []Complex) {
+	for size := 2; size <= len(arr); size <<= 1 {
+		stage(arr, tw, size, nil)
+	}
+}
+
+// stage runs the butterflies that combine sub-transforms of length size/2
+// into transforms of length size. A non-nil ops tallies the butterfly
+// operations.
+func stage(arr []Complex, tw []Complex, size int, ops *opCounter) {
+	n := len(arr)
+	half := size / 2
+	step := n / size
+	var adds, muls int
+	for start := 0; start < n; start += size {
+		for k := range half {
+			p := arr[start+k]
+			q := tw[k*step].Mul(arr[start+k+half])
+			arr[start+k] = p.Add(q)
+			arr[start+k+half] = p.Sub(q)
+			adds += 2
+			muls++
+		}
+	}
+	ops.add(adds, muls)
+}
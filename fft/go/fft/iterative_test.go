@@ -0,0 +1,117 @@
+package fft
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestBitReverseTableIsInvolution(t *testing.T) {
+	for _, n := range []int{1, 2, 8, 64, 1024} {
+		table := BitReverseTable(n)
+		for i, j := range table {
+			if table[j] != i {
+				t.Fatalf("n=%d: table[table[%d]] = %d, want %d", n, i, table[j], i)
+			}
+		}
+
+		x := randomSignal(n, int64(n))
+		y := Clone(x)
+		permute(y, table)
+		want := Clone(x)
+		BitReverse(want)
+		if d := maxDiff(y, want); d != 0 {
+			t.Errorf("n=%d: permute differs from BitReverse", n)
+		}
+		permute(y, table)
+		if d := maxDiff(y, x); d != 0 {
+			t.Errorf("n=%d: permuting twice is not the identity", n)
+		}
+	}
+}
+
+func TestFFTIterativeMatchesFFT(t *testing.T) {
+	for _, n := range []int{2, 4, 8, 64, 1024, 1 << 14} {
+		x := randomSignal(n, int64(n))
+		want := Clone(x)
+		FFT(want)
+		if err := FFTIterative(x); err != nil {
+			t.Fatalf("n=%d: %v", n, err)
+		}
+		if d := maxDiff(x, want); d > 1e-12 {
+			t.Errorf("n=%d: FFTIterative differs from FFT by %g", n, d)
+		}
+	}
+	x := fromReals(1, 2, 3, 4, 5, 6)
+	if err := FFTIterative(x); !errors.Is(err, ErrNotPowerOfTwo) {
+		t.Errorf("length 6: err = %v, want ErrNotPowerOfTwo", err)
+	}
+	if got := reals(x); !slices.Equal(got, []float64{1, 2, 3, 4, 5, 6}) {
+		t.Errorf("FFTIterative modified its input on error: %v", got)
+	}
+}
+
+// recurrenceFFT is FFTIterative with the twiddles stepped by a running
+// product w *= wn, as before the transforms read them from a table. It is
+// kept only to show how much phase error the table saves.
+func recurrenceFFT(arr []Complex) {
+	n := len(arr)
+	BitReverse(arr)
+	for size := 2; size <= n; size <<= 1 {
+		ang := -2 * math.Pi / float64(size)
+		wn := Complex{math.Cos(ang), math.Sin(ang)}
+		for start := 0; start < n; start += size {
+			w := Complex{1, 0}
+			for k := range size / 2 {
+				p := arr[start+k]
+				q := w.Mul(arr[start+k+size/2])
+				arr[start+k] = p.Add(q)
+				arr[start+k+size/2] = p.Sub(q)
+				w = w.Mul(wn)
+			}
+		}
+	}
+	normalize(arr)
+}
+
+func TestTwiddleTableAccuracy(t *testing.T) {
+	// a sum of tones at exact bins has a known spectrum: each amplitude
+	// times sqrt(n) in its bin and zeros elsewhere
+	const n = 1 << 18
+	r := rand.New(rand.NewSource(1))
+	x := make([]Complex, n)
+	want := make([]Complex, n)
+	for range 8 {
+		k := r.Intn(n)
+		a := FromPolar(1, 2*math.Pi*r.Float64())
+		want[k] = want[k].Add(a.MulScalar(math.Sqrt(n)))
+		for i := range x {
+			x[i] = x[i].Add(a.Mul(FromPolar(1, 2*math.Pi*float64(k*i%n)/n)))
+		}
+	}
+
+	old := Clone(x)
+	recurrenceFFT(old)
+	oldErr := maxDiff(old, want)
+	for name, transform := range map[string]func([]Complex){
+		"FFT":          FFT[float64],
+		"FFTIterative": func(arr []Complex) { FFTIterative(arr) },
+	} {
+		y := Clone(x)
+		transform(y)
+		// the peaks are 512 high, so 1e-12 is a relative error near 2e-15
+		if d := maxDiff(y, want); d > 1e-12 || d > oldErr/100 {
+			t.Errorf("%s: error %g at n=2^18, want <= 1e-12 and 100x below the recurrence's %g", name, d, oldErr)
+		}
+	}
+}
+
+// BenchmarkFFTIterative compares the iterative transform with the recursive
+// FFT at 2^20, allocations included.
+func BenchmarkFFTIterative(b *testing.B) {
+	benchCompare(b, []int{20},
+		namedTransform{"FFT", FFT[float64]},
+		namedTransform{"FFTIterative", func(arr []Complex) { FFTIterative(arr) }})
+}
@@ -0,0 +1,62 @@
+package fft
+
+import "math"
+
+// FFTMixedRadix handles any length by peeling off factors of 2, 3 and 5; a
+// remaining factor with no such divisor is transformed with Bluestein.
+func FFTMixedRadix(arr []Complex) {
+	if len(arr) <= 1 {
+		return
+	}
+	mixedRadix(arr)
+	normalize(arr)
+}
+
+func mixedRadix(arr []Complex) {
+	n := len(arr)
+	if n == 1 {
+		return
+	}
+	p := 0
+	for _, f := range []int{2, 3, 5} {
+		if n%f == 0 {
+			p = f
+			break
+		}
+	}
+	if p == 0 {
+		bluestein(arr)
+		return
+	}
+
+	// sub-transform r holds the samples at p*j+r
+	m := n / p
+	sub := make([]Complex, n)
+	for r := range p {
+		for j := range m {
+			sub[r*m+j] = arr[p*j+r]
+		}
+		mixedRadix(sub[r*m : (r+1)*m])
+	}
+
+	roots := make([]Complex, p)
+	for q := range p {
+		ang := -2 * math.Pi * float64(q) / float64(p)
+		roots[q] = Complex{math.Cos(ang), math.Sin(ang)}
+	}
+	t := make([]Complex, p)
+	for k := range m {
+		for r := range p {
+			ang := -2 * math.Pi * float64(r*k) / float64(n)
+			t[r] = sub[r*m+k].Mul(Complex{math.Cos(ang), math.Sin(ang)})
+		}
+		// length-p DFT of the twiddled sub-transform outputs
+		for q := range p {
+			var sum Complex
+			for r := range p {
+				sum = sum.Add(t[r].Mul(roots[r*q%p]))
+			}
+			arr[k+q*m] = sum
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package fft
+
+import "testing"
+
+func TestFFTMixedRadixMatchesDFT(t *testing.T) {
+	// smooth lengths use only the 2, 3 and 5 butterflies; 14 and 77 leave
+	// factors of 7 and 11 for Bluestein
+	for _, n := range []int{2, 3, 5, 6, 12, 15, 30, 360, 14, 77} {
+		x := randomSignal(n, int64(n))
+		want := DFT(x)
+		FFTMixedRadix(x)
+		if d := maxDiff(x, want); d > 1e-10 {
+			t.Errorf("n=%d: FFTMixedRadix differs from DFT by %g", n, d)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package fft
+
+import "math"
+
+// Norm selects how a transform of length n is scaled. The names follow
+// NumPy: NormBackward leaves the forward transform unscaled and divides the
+// inverse by n, NormForward does the opposite, and NormOrtho scales both by
+// 1/sqrt(n) as FFT and IFFT do. NormNone never scales.
+type Norm int
+
+const (
+	NormNone Norm = iota
+	NormOrtho
+	NormForward
+	NormBackward
+)
+
+func (norm Norm) factor(n int, inverse bool) float64 {
+	switch {
+	case norm == NormOrtho:
+		return 1 / math.Sqrt(float64(n))
+	case norm == NormForward && !inverse, norm == NormBackward && inverse:
+		return 1 / float64(n)
+	default:
+		return 1
+	}
+}
+
+func FFTNorm(arr []Complex, norm Norm) {
+	if len(arr) <= 1 {
+		return
+	}
+	fftCore(arr, false)
+	scale(arr, norm.factor(len(arr), false))
+}
+
+func IFFTNorm(arr []Complex, norm Norm) {
+	if len(arr) <= 1 {
+		return
+	}
+	fftCore(arr, true)
+	scale(arr, norm.factor(len(arr), true))
+}
+
+func scale[T Float](arr []ComplexG[T], factor T) {
+	if factor == 1 {
+		return
+	}
+	for i := range arr {
+		arr[i] = arr[i].MulScalar(factor)
+	}
+}
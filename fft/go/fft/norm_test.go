@@ -0,0 +1,50 @@
+package fft
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNormScaling(t *testing.T) {
+	const n = 16
+	x := randomSignal(n, 1)
+	raw := Clone(x)
+	FFTUnnormalized(raw)
+	rawInv := Clone(x)
+	fftCore(rawInv, true)
+
+	tests := []struct {
+		norm          Norm
+		forward, back float64
+	}{
+		{NormNone, 1, 1},
+		{NormOrtho, 1 / math.Sqrt(n), 1 / math.Sqrt(n)},
+		{NormForward, 1.0 / n, 1},
+		{NormBackward, 1, 1.0 / n},
+	}
+	for _, tt := range tests {
+		got := Clone(x)
+		FFTNorm(got, tt.norm)
+		want := Clone(raw)
+		ScaleInPlace(want, tt.forward)
+		if d := maxDiff(got, want); d > 1e-12 {
+			t.Errorf("FFTNorm(%d) differs from the raw transform times %g by %g", tt.norm, tt.forward, d)
+		}
+
+		got = Clone(x)
+		IFFTNorm(got, tt.norm)
+		want = Clone(rawInv)
+		ScaleInPlace(want, tt.back)
+		if d := maxDiff(got, want); d > 1e-12 {
+			t.Errorf("IFFTNorm(%d) differs from the raw inverse times %g by %g", tt.norm, tt.back, d)
+		}
+
+		if tt.norm == NormNone {
+			continue // unscaled both ways, so not an inverse pair
+		}
+		FFTNorm(got, tt.norm)
+		if d := maxDiff(got, x); d > 1e-12 {
+			t.Errorf("FFTNorm(IFFTNorm(x), %d) differs from x by %g", tt.norm, d)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package fft
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+func IsPow2(n int) bool {
+	return n > 0 && n&(n-1) == 0
+}
+
+// maxPow2 is the largest power of two an int can hold.
+const maxPow2 = 1 << (bits.UintSize - 2)
+
+// NextPow2 returns the smallest power of two >= n, which is 1 for n <= 1.
+// It panics for n > maxPow2, whose next power of two overflows int.
+func NextPow2(n int) int {
+	if n <= 1 {
+		return 1
+	}
+	if n > maxPow2 {
+		panic(fmt.Sprintf("fft: NextPow2(%d) overflows int", n))
+	}
+	return 1 << bits.Len(uint(n-1))
+}
+
+// ZeroPad returns a copy of arr extended with zeros to length size.
+func ZeroPad(arr []Complex, size int) ([]Complex, error) {
+	if size < len(arr) {
+		return nil, fmt.Errorf("fft: %w: pad size %d is shorter than input length %d", ErrLengthMismatch, size, len(arr))
+	}
+	out := make([]Complex, size)
+	copy(out, arr)
+	return out, nil
+}
@@ -0,0 +1,43 @@
+package fft
+
+import "testing"
+
+func TestIsPow2(t *testing.T) {
+	tests := []struct {
+		n    int
+		want bool
+	}{
+		{-4, false},
+		{0, false},
+		{1, true},
+		{2, true},
+		{3, false},
+		{6, false},
+		{1 << 40, true},
+		{1<<40 + 1, false},
+	}
+	for _, tt := range tests {
+		if got := IsPow2(tt.n); got != tt.want {
+			t.Errorf("IsPow2(%d) = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestNextPow2(t *testing.T) {
+	tests := []struct{ n, want int }{
+		{-5, 1}, {0, 1}, {1, 1}, {2, 2}, {3, 4}, {4, 4}, {5, 8},
+		{1000, 1024}, {1 << 40, 1 << 40}, {1<<40 + 1, 1 << 41}, {maxPow2, maxPow2},
+	}
+	for _, tt := range tests {
+		if got := NextPow2(tt.n); got != tt.want {
+			t.Errorf("NextPow2(%d) = %d, want %d", tt.n, got, tt.want)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NextPow2(%d) did not panic", maxPow2+1)
+		}
+	}()
+	NextPow2(maxPow2 + 1)
+}
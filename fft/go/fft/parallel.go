@@ -0,0 +1,39 @@
+package fft
+
+import "sync"
+
+// ParallelThreshold is the sub-transform length below which FFTParallel
+// stops spawning goroutines and recurses serially.
+var ParallelThreshold = 1 << 14
+
+func FFTParallel(arr []Complex) {
+	if len(arr) <= 1 {
+		return
+	}
+	scratch := getScratch[float64](len(arr))
+	fftParallel(arr, *scratch, cachedTwiddles(len(arr)), -1, ParallelThreshold, nil)
+	putScratch(scratch)
+	normalize(arr)
+}
+
+// fftParallel follows the same scratch ping-pong as fftScratch, so the two
+// goroutines at each level work on disjoint halves of arr and scratch.
+// Sub-transforms shorter than threshold run serially.
+func fftParallel(arr, scratch, tw []Complex, sign float64, threshold int, ops *opCounter) {
+	n := len(arr)
+	if n < 2 || n < threshold {
+		fftScratch(arr, scratch, tw, sign, ops)
+		return
+	}
+
+	a0, a1 := deinterleave(arr, scratch)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		fftParallel(a0, arr[:n/2], tw, sign, threshold, ops)
+	}()
+	fftParallel(a1, arr[n/2:], tw, sign, threshold, ops)
+	wg.Wait()
+	merge(arr, a0, a1, tw, sign, ops)
+}
@@ -0,0 +1,29 @@
+package fft
+
+import "testing"
+
+func TestFFTParallelMatchesFFT(t *testing.T) {
+	defer func(old int) { ParallelThreshold = old }(ParallelThreshold)
+	for _, threshold := range []int{64, 1 << 14} {
+		ParallelThreshold = threshold
+		// below, at and above the threshold; both paths run the same
+		// recursion, so the results are bit-identical
+		for _, n := range []int{2, 16, threshold / 2, threshold, 4 * threshold} {
+			x := randomSignal(n, int64(n))
+			want := Clone(x)
+			FFT(want)
+			FFTParallel(x)
+			if d := maxDiff(x, want); d != 0 {
+				t.Errorf("threshold %d, n=%d: FFTParallel differs from FFT by %g", threshold, n, d)
+			}
+		}
+	}
+}
+
+// BenchmarkFFTParallel compares FFTParallel with FFT at a size too small to
+// split and at 2^22.
+func BenchmarkFFTParallel(b *testing.B) {
+	benchCompare(b, []int{10, 22},
+		namedTransform{"FFT", FFT[float64]},
+		namedTransform{"FFTParallel", FFTParallel})
+}
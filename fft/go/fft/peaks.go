@@ -0,0 +1,69 @@
+package fft
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// FindPeaks returns the bins that are local maxima of the magnitude
+// spectrum and exceed threshold, strongest first with ties broken by bin
+// order, keeping at most maxPeaks of them (all if maxPeaks <= 0). A plateau
+// of equal magnitudes counts as one peak at its first bin. With skipDC the
+// DC bin is left out entirely, so a large offset neither shows up as a peak
+// nor hides one at bin 1.
+func FindPeaks(spectrum []Complex, threshold float64, maxPeaks int, skipDC bool) []int {
+	n := len(spectrum)
+	mags := make([]float64, n)
+	for i, c := range spectrum {
+		mags[i] = c.Abs()
+	}
+
+	start := 0
+	if skipDC {
+		start = 1
+	}
+	peaks := []int{}
+	for i := start; i < n; {
+		j := i
+		for j+1 < n && mags[j+1] == mags[i] {
+			j++
+		}
+		left, right := math.Inf(-1), math.Inf(-1)
+		if i > start {
+			left = mags[i-1]
+		}
+		if j < n-1 {
+			right = mags[j+1]
+		}
+		if mags[i] > threshold && mags[i] > left && mags[i] > right {
+			peaks = append(peaks, i)
+		}
+		i = j + 1
+	}
+
+	slices.SortStableFunc(peaks, func(a, b int) int {
+		return cmp.Compare(mags[b], mags[a])
+	})
+	if maxPeaks > 0 && len(peaks) > maxPeaks {
+		peaks = peaks[:maxPeaks]
+	}
+	return peaks
+}
+
+// InterpolatePeak refines bin to a fractional position by fitting a
+// parabola through the log-magnitudes of it and its neighbours. Bins at
+// either edge, or without a usable fit, are returned unchanged.
+func InterpolatePeak(spectrum []Complex, bin int) float64 {
+	if bin <= 0 || bin >= len(spectrum)-1 {
+		return float64(bin)
+	}
+	a := math.Log(spectrum[bin-1].Abs())
+	b := math.Log(spectrum[bin].Abs())
+	c := math.Log(spectrum[bin+1].Abs())
+	delta := 0.5 * (a - c) / (a - 2*b + c)
+	if math.IsNaN(delta) || math.IsInf(delta, 0) {
+		return float64(bin)
+	}
+	return float64(bin) + max(-0.5, min(0.5, delta))
+}
@@ -0,0 +1,38 @@
+package fft
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFindPeaks(t *testing.T) {
+	spectrum := fromReals(9, 1, 5, 2, 2, 7, 7, 3, 4, 0)
+	tests := []struct {
+		threshold float64
+		maxPeaks  int
+		skipDC    bool
+		want      []int
+	}{
+		{0, 0, false, []int{0, 5, 2, 8}},
+		{0, 2, false, []int{0, 5}},
+		{4.5, 0, false, []int{0, 5, 2}},
+		{0, 0, true, []int{5, 2, 8}},
+	}
+	for _, tt := range tests {
+		got := FindPeaks(spectrum, tt.threshold, tt.maxPeaks, tt.skipDC)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("FindPeaks(threshold %g, max %d, skipDC %v) = %v, want %v", tt.threshold, tt.maxPeaks, tt.skipDC, got, tt.want)
+		}
+	}
+}
+
+func TestFindPeaksSkipDCUncoversBin1(t *testing.T) {
+	// bin 1 is below DC, so it is only a peak once DC is ignored
+	spectrum := fromReals(10, 4, 1, 0)
+	if got := FindPeaks(spectrum, 0, 0, false); !slices.Equal(got, []int{0}) {
+		t.Errorf("with DC = %v, want [0]", got)
+	}
+	if got := FindPeaks(spectrum, 0, 0, true); !slices.Equal(got, []int{1}) {
+		t.Errorf("skipDC = %v, want [1]", got)
+	}
+}
@@ -0,0 +1,151 @@
+package fft
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// Direction selects the transform a Plan's Execute runs.
+type Direction int
+
+const (
+	Forward Direction = iota
+	Inverse
+)
+
+type Plan struct {
+	n        int
+	twiddles []Complex
+	reverse  []int // BitReverseTable(n)
+
+	norm      Norm
+	direction Direction
+	parallel  int // minimum length for a parallel Execute; 0 disables it
+
+	adds, muls atomic.Int64 // butterfly operations of the last Execute
+}
+
+// PlanOption configures what Execute does; see WithNorm, WithDirection and
+// WithParallel.
+type PlanOption func(*Plan)
+
+// WithNorm sets the scaling of Execute. The default is NormOrtho.
+func WithNorm(norm Norm) PlanOption {
+	return func(p *Plan) { p.norm = norm }
+}
+
+// WithDirection sets whether Execute runs the forward or inverse transform.
+// The default is Forward.
+func WithDirection(dir Direction) PlanOption {
+	return func(p *Plan) { p.direction = dir }
+}
+
+// WithParallel makes Execute split the transform across goroutines, as
+// FFTParallel does, down to sub-transforms of threshold elements. It only
+// applies when the plan length is at least threshold; threshold <= 0, the
+// default, keeps Execute serial.
+func WithParallel(threshold int) PlanOption {
+	return func(p *Plan) { p.parallel = max(threshold, 0) }
+}
+
+func NewPlan(n int, opts ...PlanOption) (*Plan, error) {
+	if !IsPow2(n) {
+		return nil, fmt.Errorf("fft: length %d is %w", n, ErrNotPowerOfTwo)
+	}
+	p := &Plan{n: n, twiddles: cachedTwiddles(n), reverse: BitReverseTable(n), norm: NormOrtho}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p, nil
+}
+
+// FFT runs the orthonormal forward transform whatever options the plan was
+// built with.
+func (p *Plan) FFT(arr []Complex) error {
+	if len(arr) != p.n {
+		return fmt.Errorf("fft: %w: plan is for length %d, got %d", ErrLengthMismatch, p.n, len(arr))
+	}
+	permute(arr, p.reverse)
+	butterflies(arr, p.twiddles)
+	normalize(arr)
+	return nil
+}
+
+// Stages splits FFT into steps that can be run one at a time, with other
+// work in between. The first puts arr in bit-reversed order and applies the
+// 1/sqrt(n) scaling; each of the log2(n) after it is one butterfly stage.
+// Running them all in order on a slice of the plan's length matches FFT up
+// to rounding. The stages do not check the length.
+func (p *Plan) Stages() []func([]Complex) {
+	stages := []func([]Complex){func(arr []Complex) {
+		permute(arr, p.reverse)
+		normalize(arr)
+	}}
+	for size := 2; size <= p.n; size <<= 1 {
+		stages = append(stages, func(arr []Complex) { stage(arr, p.twiddles, size, nil) })
+	}
+	return stages
+}
+
+// Execute transforms arr in place with the plan's direction, scaling and
+// parallelism.
+func (p *Plan) Execute(arr []Complex) error {
+	if len(arr) != p.n {
+		return fmt.Errorf("fft: %w: plan is for length %d, got %d", ErrLengthMismatch, p.n, len(arr))
+	}
+	inverse := p.direction == Inverse
+	var ops opCounter
+	if p.parallel > 0 && p.n >= p.parallel {
+		sign := -1.0
+		if inverse {
+			sign = 1
+		}
+		scratch := getScratch[float64](p.n)
+		fftParallel(arr, *scratch, p.twiddles, sign, p.parallel, &ops)
+		putScratch(scratch)
+	} else {
+		// the inverse is the forward transform of the conjugate, conjugated
+		if inverse {
+			conjugate(arr)
+		}
+		permute(arr, p.reverse)
+		for size := 2; size <= p.n; size <<= 1 {
+			stage(arr, p.twiddles, size, &ops)
+		}
+		if inverse {
+			conjugate(arr)
+		}
+	}
+	p.adds.Store(ops.adds.Load())
+	p.muls.Store(ops.muls.Load())
+	scale(arr, p.norm.factor(p.n, inverse))
+	return nil
+}
+
+// OpCounts returns the complex additions (subtractions included) and
+// multiplications done by the butterflies of the most recent Execute, or
+// zeros before the first. Reordering, conjugation and the final scaling are
+// not counted.
+func (p *Plan) OpCounts() (adds, muls int) {
+	return int(p.adds.Load()), int(p.muls.Load())
+}
+
+// opCounter tallies the complex additions and multiplications of the
+// butterflies it is passed to. The parallel path shares one between
+// goroutines, hence the atomics; a nil *opCounter counts nothing.
+type opCounter struct {
+	adds, muls atomic.Int64
+}
+
+func (c *opCounter) add(adds, muls int) {
+	if c != nil {
+		c.adds.Add(int64(adds))
+		c.muls.Add(int64(muls))
+	}
+}
+
+func conjugate(arr []Complex) {
+	for i, c := range arr {
+		arr[i] = c.Conj()
+	}
+}
@@ -0,0 +1,75 @@
+package fft
+
+import (
+	"math/bits"
+	"testing"
+)
+
+func TestOpCounts(t *testing.T) {
+	for _, n := range []int{2, 4, 8, 64, 1024} {
+		lg := bits.TrailingZeros(uint(n))
+		tests := []struct {
+			name       string
+			opts       []PlanOption
+			adds, muls int
+		}{
+			// log2(n) stages of n/2 butterflies, each one multiply and two adds
+			{"serial", nil, n * lg, n / 2 * lg},
+			// below 8 the recursion goes serial and stops at length 4, whose
+			// twiddles need no multiplies
+			{"parallel", []PlanOption{WithParallel(8)}, n * lg, n / 2 * (lg - 2)},
+		}
+		for _, tt := range tests {
+			if tt.name == "parallel" && n < 8 {
+				continue // shorter than the threshold, so Execute runs serially
+			}
+			p, err := NewPlan(n, tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if adds, muls := p.OpCounts(); adds != 0 || muls != 0 {
+				t.Errorf("n=%d %s: OpCounts before Execute = %d, %d, want 0, 0", n, tt.name, adds, muls)
+			}
+			x := randomSignal(n, int64(n))
+			want := Clone(x)
+			FFT(want)
+			if err := p.Execute(x); err != nil {
+				t.Fatal(err)
+			}
+			if d := maxDiff(x, want); d > 1e-12 {
+				t.Errorf("n=%d %s: Execute differs from FFT by %g", n, tt.name, d)
+			}
+			if adds, muls := p.OpCounts(); adds != tt.adds || muls != tt.muls {
+				t.Errorf("n=%d %s: OpCounts = %d, %d, want %d, %d", n, tt.name, adds, muls, tt.adds, tt.muls)
+			}
+		}
+	}
+}
+
+func TestPlanStagesMatchFFT(t *testing.T) {
+	for _, n := range []int{1, 2, 8, 1024} {
+		p, err := NewPlan(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stages := p.Stages()
+		if want := bits.TrailingZeros(uint(n)) + 1; len(stages) != want {
+			t.Fatalf("n=%d: %d stages, want %d", n, len(stages), want)
+		}
+		x := randomSignal(n, int64(n))
+		staged, executed, want := Clone(x), Clone(x), Clone(x)
+		for _, s := range stages {
+			s(staged)
+		}
+		if err := p.Execute(executed); err != nil {
+			t.Fatal(err)
+		}
+		FFT(want)
+		if d := maxDiff(staged, executed); d > 1e-12 {
+			t.Errorf("n=%d: stages differ from Execute by %g", n, d)
+		}
+		if d := maxDiff(staged, want); d > 1e-12 {
+			t.Errorf("n=%d: stages differ from FFT by %g", n, d)
+		}
+	}
+}
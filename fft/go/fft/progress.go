@@ -0,0 +1,40 @@
+package fft
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// FFTProgress is FFTIterative that calls onProgress with the fraction of
+// butterfly stages done after each of the log2(n) stages, ending with 1 once
+// arr is fully transformed. onProgress may be nil, which costs nothing.
+// len(arr) must be a power of two; other lengths return ErrNotPowerOfTwo
+// and leave arr untouched without calling onProgress.
+func FFTProgress(arr []Complex, onProgress func(fraction float64)) error {
+	report := func(f float64) {
+		if onProgress != nil {
+			onProgress(f)
+		}
+	}
+	n := len(arr)
+	if n <= 1 {
+		report(1)
+		return nil
+	}
+	if !IsPow2(n) {
+		return fmt.Errorf("fft: length %d is %w", n, ErrNotPowerOfTwo)
+	}
+
+	BitReverse(arr)
+	tw := cachedTwiddles(n)
+	total := bits.TrailingZeros(uint(n))
+	for s, size := 1, 2; size <= n; s, size = s+1, size<<1 {
+		stage(arr, tw, size, nil)
+		if s < total {
+			report(float64(s) / float64(total))
+		}
+	}
+	normalize(arr)
+	report(1)
+	return nil
+}
@@ -0,0 +1,44 @@
+package fft
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFFTProgress(t *testing.T) {
+	for _, n := range []int{1, 2, 8, 1024} {
+		x := randomSignal(n, int64(n))
+		want := Clone(x)
+		FFT(want)
+
+		var fractions []float64
+		if err := FFTProgress(x, func(f float64) { fractions = append(fractions, f) }); err != nil {
+			t.Fatalf("n=%d: %v", n, err)
+		}
+		if d := maxDiff(x, want); d > 1e-12 {
+			t.Errorf("n=%d: max diff from FFT %g", n, d)
+		}
+		if len(fractions) == 0 || fractions[len(fractions)-1] != 1 {
+			t.Fatalf("n=%d: fractions %v do not end at 1", n, fractions)
+		}
+		for i, f := range fractions {
+			if f <= 0 || f > 1 || i > 0 && f <= fractions[i-1] {
+				t.Errorf("n=%d: fractions %v do not increase monotonically to 1", n, fractions)
+				break
+			}
+		}
+	}
+
+	x := randomSignal(6, 1)
+	orig := Clone(x)
+	called := false
+	if err := FFTProgress(x, func(float64) { called = true }); !errors.Is(err, ErrNotPowerOfTwo) {
+		t.Errorf("n=6: err = %v, want ErrNotPowerOfTwo", err)
+	}
+	if called || maxDiff(x, orig) != 0 {
+		t.Errorf("n=6: onProgress called = %v, arr modified = %v", called, maxDiff(x, orig) != 0)
+	}
+	if err := FFTProgress(randomSignal(16, 1), nil); err != nil {
+		t.Errorf("nil onProgress: %v", err)
+	}
+}
@@ -0,0 +1,139 @@
+package fft
+
+import "fmt"
+
+// PSD returns |X[k]|^2 for the orthonormal FFT of signal, which already
+// carries the 1/n scaling of the raw transform; its sum equals the energy of
+// signal. The input is left untouched.
+func PSD(signal []Complex) []float64 {
+	spec := make([]Complex, len(signal))
+	copy(spec, signal)
+	FFT(spec)
+	return MagnitudesSquared(spec)
+}
+
+// PSDReal is PSD for a real signal, returning only the n/2+1 non-redundant
+// bins.
+func PSDReal(signal []float64) []float64 {
+	return MagnitudesSquared(RFFT(signal))
+}
+
+// MagnitudesSquared returns |x|^2 for each element of arr.
+func MagnitudesSquared(arr []Complex) []float64 {
+	out := make([]float64, len(arr))
+	for i, c := range arr {
+		out[i] = c.Abs2()
+	}
+	return out
+}
+
+// Welch estimates the PSD of signal by averaging the periodograms of
+// segments of segLen samples overlapping by overlap samples. Each segment is
+// weighted by window (nil for none) and its periodogram divided by the mean
+// window power, so white noise of variance s^2 gives a flat s^2 in each of
+// the segLen/2+1 bins, matching PSDReal for an unwindowed single segment.
+func Welch(signal []float64, segLen, overlap int, window []float64) ([]float64, error) {
+	if !IsPow2(segLen) {
+		return nil, fmt.Errorf("fft: segment length %d is %w", segLen, ErrNotPowerOfTwo)
+	}
+	if overlap < 0 || overlap >= segLen {
+		return nil, fmt.Errorf("fft: overlap %d must be in [0, %d)", overlap, segLen)
+	}
+	if window != nil && len(window) != segLen {
+		return nil, fmt.Errorf("fft: %w: window length %d, segment length %d", ErrLengthMismatch, len(window), segLen)
+	}
+	if len(signal) == 0 {
+		return nil, fmt.Errorf("fft: welch: %w", ErrEmptyInput)
+	}
+	if len(signal) < segLen {
+		return nil, fmt.Errorf("fft: %w: signal length %d is shorter than segment length %d", ErrLengthMismatch, len(signal), segLen)
+	}
+
+	u := 1.0
+	if window != nil {
+		u = 0
+		for _, w := range window {
+			u += w * w
+		}
+		u /= float64(segLen)
+	}
+
+	out := make([]float64, segLen/2+1)
+	seg := make([]float64, segLen)
+	count := 0
+	for start := 0; start+segLen <= len(signal); start += segLen - overlap {
+		copy(seg, signal[start:])
+		if window != nil {
+			for i, w := range window {
+				seg[i] *= w
+			}
+		}
+		for k, p := range PSDReal(seg) {
+			out[k] += p
+		}
+		count++
+	}
+	for k := range out {
+		out[k] /= u * float64(count)
+	}
+	return out, nil
+}
+
+// Scaling selects the units of Periodogram: ScalingDensity gives a power
+// spectral density in units^2/Hz, ScalingSpectrum a power spectrum in
+// units^2.
+type Scaling int
+
+const (
+	ScalingDensity Scaling = iota
+	ScalingSpectrum
+)
+
+// Sided selects whether Periodogram folds negative frequencies onto
+// positive ones (OneSided) or keeps every bin (TwoSided).
+type Sided int
+
+const (
+	OneSided Sided = iota
+	TwoSided
+)
+
+// Periodogram returns the frequencies and power of signal sampled at
+// sampleRate, following scipy.signal.periodogram with no window or detrend.
+// With raw DFT X of length n, the two-sided power is |X[k]|^2/n^2 for
+// ScalingSpectrum and |X[k]|^2/(n*sampleRate) for ScalingDensity, so the
+// spectrum sums, and the density integrates, to the mean square of signal.
+// A sine of amplitude A then shows A^2/4 at each of +f and -f. OneSided
+// returns the n/2+1 bins of RFreqBins with every bin except DC and an
+// even-n Nyquist doubled; TwoSided returns n bins in FreqBins order. Any
+// length is accepted; sampleRate must be positive.
+func Periodogram(signal []float64, sampleRate float64, scaling Scaling, sided Sided) ([]float64, []float64) {
+	n := len(signal)
+	spec := make([]Complex, n)
+	for i, v := range signal {
+		spec[i] = Complex{v, 0}
+	}
+	rawTransform(spec)
+
+	norm := float64(n) * sampleRate
+	if scaling == ScalingSpectrum {
+		norm = float64(n) * float64(n)
+	}
+	if sided == TwoSided {
+		power := MagnitudesSquared(spec)
+		for k := range power {
+			power[k] /= norm
+		}
+		return FreqBins(n, sampleRate), power
+	}
+
+	freqs := RFreqBins(n, sampleRate)
+	power := make([]float64, len(freqs))
+	for k := range power {
+		power[k] = spec[k].Abs2() / norm
+		if k > 0 && 2*k != n {
+			power[k] *= 2
+		}
+	}
+	return freqs, power
+}
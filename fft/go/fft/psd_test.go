@@ -0,0 +1,156 @@
+package fft
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// meanAndRelStd returns the mean of vs and their standard deviation over
+// that mean.
+func meanAndRelStd(vs []float64) (mean, relStd float64) {
+	for _, v := range vs {
+		mean += v
+	}
+	mean /= float64(len(vs))
+	for _, v := range vs {
+		relStd += (v - mean) * (v - mean)
+	}
+	return mean, math.Sqrt(relStd/float64(len(vs))) / mean
+}
+
+func TestWelchWhiteNoiseIsFlat(t *testing.T) {
+	const sigma = 2
+	r := rand.New(rand.NewSource(1))
+	x := make([]float64, 1<<16)
+	for i := range x {
+		x[i] = sigma * r.NormFloat64()
+	}
+
+	// a single periodogram is as noisy as the noise: its bins scatter by
+	// about 100% of their mean
+	_, single := meanAndRelStd(PSDReal(x[:256])[1:128])
+	for name, window := range map[string][]float64{"none": nil, "Hann": Hann(256)} {
+		p, err := Welch(x, 256, 128, window)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(p) != 129 {
+			t.Fatalf("%s: %d bins, want 129", name, len(p))
+		}
+		// averaging about 500 segments brings the scatter down to a few
+		// percent around the variance
+		mean, relStd := meanAndRelStd(p[1:128])
+		if math.Abs(mean-sigma*sigma) > 0.03*sigma*sigma {
+			t.Errorf("%s window: mean PSD %g, want the variance %g", name, mean, float64(sigma*sigma))
+		}
+		if relStd > 0.1 || relStd > single/5 {
+			t.Errorf("%s window: bins scatter by %.1f%%, single periodogram by %.1f%%", name, 100*relStd, 100*single)
+		}
+	}
+}
+
+func TestWelchValidation(t *testing.T) {
+	x := make([]float64, 64)
+	tests := []struct {
+		name            string
+		signal          []float64
+		segLen, overlap int
+		window          []float64
+		want            error
+	}{
+		{"segLen 0", x, 0, 0, nil, ErrNotPowerOfTwo},
+		{"segLen 24", x, 24, 0, nil, ErrNotPowerOfTwo},
+		{"negative overlap", x, 16, -1, nil, nil},
+		{"overlap = segLen", x, 16, 16, nil, nil},
+		{"short window", x, 16, 8, make([]float64, 15), ErrLengthMismatch},
+		{"empty signal", nil, 16, 8, nil, ErrEmptyInput},
+		{"short signal", x[:8], 16, 8, nil, ErrLengthMismatch},
+	}
+	for _, tt := range tests {
+		_, err := Welch(tt.signal, tt.segLen, tt.overlap, tt.window)
+		if err == nil || tt.want != nil && !errors.Is(err, tt.want) {
+			t.Errorf("%s: err = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestPeriodogramScalingAndSides(t *testing.T) {
+	const fs, k0 = 1000.0, 5
+	const c, a, b = 0.5, 3.0, 2.0 // DC offset, sine and Nyquist amplitudes
+	for _, n := range []int{64, 63} {
+		signal := make([]float64, n)
+		for i := range signal {
+			signal[i] = c + a*math.Sin(2*math.Pi*k0*float64(i)/float64(n))
+			if n%2 == 0 {
+				signal[i] += b * math.Cos(math.Pi*float64(i))
+			}
+		}
+		meanSquare := 0.0
+		for _, v := range signal {
+			meanSquare += v * v / float64(n)
+		}
+
+		for _, scaling := range []Scaling{ScalingSpectrum, ScalingDensity} {
+			// density is spectrum per unit frequency, one bin being fs/n wide
+			unit := 1.0
+			if scaling == ScalingDensity {
+				unit = float64(n) / fs
+			}
+			for _, sided := range []Sided{TwoSided, OneSided} {
+				freqs, power := Periodogram(signal, fs, scaling, sided)
+				want := make([]float64, n)
+				wantFreqs := FreqBins(n, fs)
+				want[0] = c * c
+				want[k0], want[n-k0] = a*a/4, a*a/4
+				if n%2 == 0 {
+					want[n/2] = b * b
+				}
+				if sided == OneSided {
+					wantFreqs = RFreqBins(n, fs)
+					want = want[:n/2+1]
+					want[k0] *= 2
+				}
+				if len(freqs) != len(want) || len(power) != len(want) {
+					t.Fatalf("n=%d scaling %d sided %d: %d freqs and %d bins, want %d", n, scaling, sided, len(freqs), len(power), len(want))
+				}
+				total := 0.0
+				for k := range power {
+					if freqs[k] != wantFreqs[k] {
+						t.Errorf("n=%d scaling %d sided %d: freqs[%d] = %g, want %g", n, scaling, sided, k, freqs[k], wantFreqs[k])
+					}
+					if math.Abs(power[k]-want[k]*unit) > 1e-12*unit {
+						t.Errorf("n=%d scaling %d sided %d: power[%d] = %g, want %g", n, scaling, sided, k, power[k], want[k]*unit)
+					}
+					total += power[k]
+				}
+				// Parseval: the spectrum sums, and the density integrates,
+				// to the mean square
+				if math.Abs(total/unit-meanSquare) > 1e-12*meanSquare {
+					t.Errorf("n=%d scaling %d sided %d: total power %g, want mean square %g", n, scaling, sided, total/unit, meanSquare)
+				}
+			}
+		}
+	}
+}
+
+func TestPeriodogramParsevalOnNoise(t *testing.T) {
+	x := randomReal(101, 1)
+	meanSquare := 0.0
+	for _, v := range x {
+		meanSquare += v * v / float64(len(x))
+	}
+	for _, sided := range []Sided{TwoSided, OneSided} {
+		_, spectrum := Periodogram(x, 8, ScalingSpectrum, sided)
+		_, density := Periodogram(x, 8, ScalingDensity, sided)
+		var sum, integral float64
+		for k := range spectrum {
+			sum += spectrum[k]
+			integral += density[k] * 8 / float64(len(x))
+		}
+		if math.Abs(sum-meanSquare) > 1e-12 || math.Abs(integral-meanSquare) > 1e-12 {
+			t.Errorf("sided %d: spectrum sums to %g and density integrates to %g, want %g", sided, sum, integral, meanSquare)
+		}
+	}
+}
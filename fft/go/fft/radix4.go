@@ -0,0 +1,66 @@
+package fft
+
+import "fmt"
+
+// FFTRadix4 works for any power of two: lengths that are not a power of
+// four bottom out in a radix-2 butterfly. Other lengths return
+// ErrNotPowerOfTwo and leave arr untouched.
+func FFTRadix4(arr []Complex) error {
+	n := len(arr)
+	if n <= 1 {
+		return nil
+	}
+	if !IsPow2(n) {
+		return fmt.Errorf("fft: length %d is %w", n, ErrNotPowerOfTwo)
+	}
+	scratch := getScratch[float64](n)
+	radix4(arr, *scratch, cachedTwiddles(n))
+	putScratch(scratch)
+	normalize(arr)
+	return nil
+}
+
+// radix4 gathers the four quarter-length decimations of arr into scratch
+// and recurses with the matching quarters of arr as their scratch, as
+// fftScratch does with halves; tw is the top-level twiddle table.
+func radix4(arr, scratch, tw []Complex) {
+	n := len(arr)
+	switch n {
+	case 1:
+		return
+	case 2:
+		arr[0], arr[1] = arr[0].Add(arr[1]), arr[0].Sub(arr[1])
+		return
+	case 4:
+		fft4(arr, -1)
+		return
+	}
+
+	q := n / 4
+	f0, f1, f2, f3 := scratch[:q], scratch[q:2*q], scratch[2*q:3*q], scratch[3*q:n]
+	for i := range q {
+		f0[i] = arr[4*i]
+		f1[i] = arr[4*i+1]
+		f2[i] = arr[4*i+2]
+		f3[i] = arr[4*i+3]
+	}
+	radix4(f0, arr[:q], tw)
+	radix4(f1, arr[q:2*q], tw)
+	radix4(f2, arr[2*q:3*q], tw)
+	radix4(f3, arr[3*q:], tw)
+
+	stride := 2 * len(tw) / n
+	for k := range q {
+		a0 := f0[k]
+		a1 := tw[k*stride].Mul(f1[k])
+		a2 := tw[2*k*stride].Mul(f2[k])
+		a3 := twiddle(tw, 3*k*stride).Mul(f3[k])
+		s0, s1 := a0.Add(a2), a0.Sub(a2)
+		t0, d := a1.Add(a3), a1.Sub(a3)
+		t1 := d.MulI().Neg()
+		arr[k] = s0.Add(t0)
+		arr[k+q] = s1.Add(t1)
+		arr[k+2*q] = s0.Sub(t0)
+		arr[k+3*q] = s1.Sub(t1)
+	}
+}
@@ -0,0 +1,39 @@
+package fft
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFFTRadix4MatchesDFT(t *testing.T) {
+	// powers of four run pure radix-4; 2*4^k end in a radix-2 butterfly
+	for _, n := range []int{1, 4, 16, 64, 256, 2, 8, 32, 128, 512} {
+		x := randomSignal(n, int64(n))
+		want := DFT(x)
+		if err := FFTRadix4(x); err != nil {
+			t.Fatal(err)
+		}
+		if d := maxDiff(x, want); d > 1e-10 {
+			t.Errorf("n=%d: FFTRadix4 differs from DFT by %g", n, d)
+		}
+	}
+}
+
+func TestFFTRadix4RejectsNonPowerOfTwo(t *testing.T) {
+	x := randomSignal(6, 1)
+	want := Clone(x)
+	if err := FFTRadix4(x); !errors.Is(err, ErrNotPowerOfTwo) {
+		t.Errorf("n=6: err = %v, want ErrNotPowerOfTwo", err)
+	}
+	if d := maxDiff(x, want); d != 0 {
+		t.Error("rejected input was modified")
+	}
+}
+
+// BenchmarkFFTRadix4 compares radix-4 with the radix-2 FFT at powers of
+// four, where radix-4 never needs its radix-2 fallback.
+func BenchmarkFFTRadix4(b *testing.B) {
+	benchCompare(b, []int{8, 16, 20},
+		namedTransform{"FFT", FFT[float64]},
+		namedTransform{"FFTRadix4", func(arr []Complex) { FFTRadix4(arr) }})
+}
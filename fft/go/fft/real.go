@@ -0,0 +1,181 @@
+package fft
+
+import (
+	"fmt"
+	"math"
+)
+
+// DetectRealInput makes FFT check whether every imaginary part is zero and,
+// if so, compute the spectrum from a half-length transform. The check costs
+// an extra O(n) scan, so it is off by default.
+var DetectRealInput = false
+
+// RFFT returns the n/2+1 non-redundant bins of the FFT of a real signal
+// whose length is a power of two.
+func RFFT(signal []float64) []Complex {
+	n := len(signal)
+	if n == 0 {
+		return []Complex{}
+	}
+	if n == 1 {
+		return []Complex{{signal[0], 0}}
+	}
+
+	m := n / 2
+	z := make([]Complex, m)
+	for j := range m {
+		z[j] = Complex{signal[2*j], signal[2*j+1]}
+	}
+	fftCore(z, false)
+
+	out := make([]Complex, m+1)
+	unpackReal(z, out)
+	scale(out, 1/math.Sqrt(float64(n)))
+	return out
+}
+
+// FFTReal returns all n bins of the FFT of a real signal, n a power of two.
+// It runs RFFT and fills the upper half from the Hermitian symmetry
+// X[n-k] = conj(X[k]).
+func FFTReal(signal []float64) []Complex {
+	n := len(signal)
+	half := RFFT(signal)
+	out := make([]Complex, n)
+	copy(out, half)
+	for k := 1; k < (n+1)/2; k++ {
+		out[n-k] = half[k].Conj()
+	}
+	return out
+}
+
+// OneSidedMagnitudes returns the magnitudes of the n/2+1 bins RFFT gives
+// for a length-n real signal, doubling every bin except DC and, for even n,
+// Nyquist, to account for the mirrored negative frequencies they stand for.
+// Dividing the result by sqrt(n) gives the amplitude of each sinusoid.
+func OneSidedMagnitudes(halfSpectrum []Complex, n int) []float64 {
+	out := make([]float64, len(halfSpectrum))
+	for k, c := range halfSpectrum {
+		out[k] = c.Abs()
+		if k > 0 && 2*k != n {
+			out[k] *= 2
+		}
+	}
+	return out
+}
+
+// FFTTwoReal returns the RFFT half spectra of two real signals of the same
+// power-of-two length from a single complex FFT: x goes in the real parts,
+// y in the imaginary parts, and the Hermitian symmetry of each real
+// spectrum separates them again, as X[k] = (Z[k] + conj(Z[n-k]))/2 and
+// Y[k] = (Z[k] - conj(Z[n-k]))/2i.
+func FFTTwoReal(x, y []float64) (X, Y []Complex, err error) {
+	n := len(x)
+	if len(y) != n {
+		return nil, nil, fmt.Errorf("fft: %w: %d and %d", ErrLengthMismatch, n, len(y))
+	}
+	if n == 0 {
+		return []Complex{}, []Complex{}, nil
+	}
+	if !IsPow2(n) {
+		return nil, nil, fmt.Errorf("fft: length %d is %w", n, ErrNotPowerOfTwo)
+	}
+
+	z := make([]Complex, n)
+	for i := range z {
+		z[i] = Complex{x[i], y[i]}
+	}
+	FFT(z)
+
+	X = make([]Complex, n/2+1)
+	Y = make([]Complex, n/2+1)
+	for k := range X {
+		zk, zc := z[k%n], z[(n-k)%n].Conj()
+		X[k] = zk.Add(zc).MulScalar(0.5)
+		Y[k] = zk.Sub(zc).MulI().Neg().MulScalar(0.5)
+	}
+	return X, Y, nil
+}
+
+// IRFFT reconstructs the length-n real signal, n a power of two, from the
+// n/2+1 bins RFFT returns. Missing bins are treated as zero.
+func IRFFT(halfSpectrum []Complex, n int) []float64 {
+	if n <= 0 {
+		return []float64{}
+	}
+	bins := make([]Complex, n/2+1)
+	copy(bins, halfSpectrum)
+	if n == 1 {
+		return []float64{bins[0].Real}
+	}
+
+	// undo unpackReal: rebuild the half-length transform of the even and
+	// odd samples packed as z[j] = x[2j] + i*x[2j+1]
+	m := n / 2
+	z := make([]Complex, m)
+	tw := cachedTwiddles(n)
+	for k := range m {
+		xk := bins[k]
+		xc := bins[m-k].Conj()
+		even := xk.Add(xc).MulScalar(0.5)
+		odd := xk.Sub(xc).Mul(tw[k].Conj()).MulScalar(0.5)
+		z[k] = even.Add(odd.MulI())
+	}
+	fftCore(z, true)
+
+	out := make([]float64, n)
+	factor := math.Sqrt(float64(n)) / float64(m)
+	for j, c := range z {
+		out[2*j] = c.Real * factor
+		out[2*j+1] = c.Imag * factor
+	}
+	return out
+}
+
+func isReal[T Float](arr []ComplexG[T]) bool {
+	for _, c := range arr {
+		if c.Imag != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// fftReal is the unnormalized transform of an arr with zero imaginary parts,
+// done as a half-length transform plus unpacking and Hermitian mirroring.
+func fftReal[T Float](arr []ComplexG[T]) {
+	n := len(arr)
+	if n < 2 {
+		return
+	}
+	m := n / 2
+	z := make([]ComplexG[T], m)
+	for j := range m {
+		z[j] = ComplexG[T]{arr[2*j].Real, arr[2*j+1].Real}
+	}
+	fftCore(z, false)
+
+	unpackReal(z, arr[:m+1])
+	for k := 1; k < m; k++ {
+		arr[n-k] = arr[k].Conj()
+	}
+}
+
+// unpackReal turns z, the transform of a length-2m real signal whose even and
+// odd samples were packed into its real and imaginary parts, into the m+1
+// unnormalized non-redundant bins of that signal's spectrum.
+func unpackReal[T Float](z, out []ComplexG[T]) {
+	m := len(z)
+	tw := cachedTwiddles(2 * m)
+	for k := range m + 1 {
+		zk := z[k%m]
+		zc := z[(m-k)%m].Conj()
+		even := zk.Add(zc).MulScalar(0.5)
+		d := zk.Sub(zc).MulScalar(0.5)
+		odd := d.MulI().Neg()
+		w := ComplexG[T]{-1, 0} // e^(-i*pi) for k = m, past the end of tw
+		if k < m {
+			w = ComplexG[T]{T(tw[k].Real), T(tw[k].Imag)}
+		}
+		out[k] = even.Add(w.Mul(odd))
+	}
+}
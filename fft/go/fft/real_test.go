@@ -0,0 +1,68 @@
+package fft
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestIRFFTRoundTrip(t *testing.T) {
+	for _, n := range []int{1, 2, 4, 16, 1024} {
+		x := randomReal(n, int64(n))
+		got := IRFFT(RFFT(x), n)
+		for i := range x {
+			if math.Abs(got[i]-x[i]) > 1e-12 {
+				t.Fatalf("n=%d: IRFFT(RFFT(x))[%d] = %g, want %g", n, i, got[i], x[i])
+			}
+		}
+	}
+}
+
+func TestFFTTwoRealMatchesRFFT(t *testing.T) {
+	for _, n := range []int{1, 2, 8, 1024} {
+		x, y := randomReal(n, 1), randomReal(n, 2)
+		if n >= 8 {
+			// put energy at Nyquist and at an odd bin in each input
+			for i := range n {
+				x[i] += math.Cos(math.Pi * float64(i))
+				y[i] += math.Sin(2 * math.Pi * 3 * float64(i) / float64(n))
+			}
+		}
+		X, Y, err := FFTTwoReal(x, y)
+		if err != nil {
+			t.Fatalf("n=%d: %v", n, err)
+		}
+		for _, in := range []struct {
+			name string
+			got  []Complex
+			sig  []float64
+		}{{"X", X, x}, {"Y", Y, y}} {
+			full := fromReals(in.sig...)
+			FFT(full)
+			rfft := RFFT(in.sig)
+			if len(in.got) != n/2+1 {
+				t.Fatalf("n=%d: %s has %d bins, want %d", n, in.name, len(in.got), n/2+1)
+			}
+			for k, c := range in.got {
+				if d := c.Sub(rfft[k]).Abs(); d > 1e-12 {
+					t.Errorf("n=%d: %s[%d] = %v, RFFT gives %v", n, in.name, k, c, rfft[k])
+				}
+				if d := c.Sub(full[k]).Abs(); d > 1e-12 {
+					t.Errorf("n=%d: %s[%d] = %v, FFT gives %v", n, in.name, k, c, full[k])
+				}
+			}
+		}
+	}
+}
+
+func TestFFTTwoRealValidation(t *testing.T) {
+	if _, _, err := FFTTwoReal(make([]float64, 8), make([]float64, 4)); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("lengths 8 and 4: err = %v, want ErrLengthMismatch", err)
+	}
+	if _, _, err := FFTTwoReal(make([]float64, 6), make([]float64, 6)); !errors.Is(err, ErrNotPowerOfTwo) {
+		t.Errorf("length 6: err = %v, want ErrNotPowerOfTwo", err)
+	}
+	if X, Y, err := FFTTwoReal(nil, nil); err != nil || len(X) != 0 || len(Y) != 0 {
+		t.Errorf("empty: X %v, Y %v, err %v", X, Y, err)
+	}
+}
@@ -0,0 +1,81 @@
+package fft
+
+import "fmt"
+
+// Resample changes the length of signal to newLen by truncating or
+// zero-padding its spectrum, preserving amplitudes and frequencies of
+// band-limited content. An even-length Nyquist bin is folded when
+// shrinking and split between +/- Nyquist when growing, so real input stays
+// real. Any lengths are accepted; newLen <= 0 returns an empty slice.
+func Resample(signal []Complex, newLen int) []Complex {
+	n, m := len(signal), max(newLen, 0)
+	out := make([]Complex, m)
+	if n == 0 || m == 0 {
+		return out
+	}
+
+	spec := make([]Complex, n)
+	copy(spec, signal)
+	rawTransform(spec)
+
+	mn := min(n, m)
+	for k := range (mn + 1) / 2 {
+		out[k] = spec[k]
+	}
+	for k := 1; k <= (mn-1)/2; k++ {
+		out[m-k] = spec[n-k]
+	}
+	if mn%2 == 0 {
+		h := mn / 2
+		switch {
+		case m < n:
+			out[h] = spec[h].Add(spec[n-h])
+		case m > n:
+			out[h] = spec[h].MulScalar(0.5)
+			out[m-h] = out[h]
+		default:
+			out[h] = spec[h]
+		}
+	}
+
+	rawInverseTransform(out)
+	scale(out, 1/float64(n))
+	return out
+}
+
+// Decimate keeps every factor-th sample of signal after removing, in the
+// frequency domain, every component at or above the new Nyquist frequency,
+// so content the lower rate cannot represent is dropped instead of aliased.
+// The result has len(signal)/factor samples; a factor of 1 returns a copy.
+// Any length is accepted.
+func Decimate(signal []float64, factor int) ([]float64, error) {
+	if factor < 1 {
+		return nil, fmt.Errorf("fft: decimation factor %d must be at least 1", factor)
+	}
+	n := len(signal)
+	out := make([]float64, n/factor)
+	if factor == 1 {
+		copy(out, signal)
+		return out, nil
+	}
+
+	spec := make([]Complex, n)
+	for i, v := range signal {
+		spec[i] = Complex{v, 0}
+	}
+	rawTransform(spec)
+	// bin k, mirrored by n-k, is at k/n cycles per sample; the new Nyquist
+	// is 1/(2*factor)
+	Apply(spec, func(k int, c Complex) Complex {
+		if 2*factor*min(k, n-k) >= n {
+			return Complex{}
+		}
+		return c
+	})
+	rawInverseTransform(spec)
+
+	for i := range out {
+		out[i] = spec[i*factor].Real / float64(n)
+	}
+	return out, nil
+}
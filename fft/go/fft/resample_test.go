@@ -0,0 +1,58 @@
+package fft
+
+import (
+	"math"
+	"testing"
+)
+
+func TestResamplePreservesSine(t *testing.T) {
+	const cycles = 10
+	for _, size := range [][2]int{{1024, 512}, {512, 1024}, {100, 37}} {
+		n, m := size[0], size[1]
+		x := make([]Complex, n)
+		for i := range x {
+			x[i] = Complex{math.Sin(2 * math.Pi * cycles * float64(i) / float64(n)), 0}
+		}
+		y := Resample(x, m)
+		if len(y) != m {
+			t.Fatalf("%d -> %d: got length %d", n, m, len(y))
+		}
+		for i, c := range y {
+			want := math.Sin(2 * math.Pi * cycles * float64(i) / float64(m))
+			if math.Abs(c.Real-want) > 1e-10 || math.Abs(c.Imag) > 1e-10 {
+				t.Fatalf("%d -> %d: sample %d = %v, want %g", n, m, i, c, want)
+			}
+		}
+	}
+}
+
+func TestDecimateRemovesAliases(t *testing.T) {
+	// decimating 512 samples by 4 moves the Nyquist limit to bin 64; the
+	// tone at bin 100 would alias onto bin 28 of the output if kept
+	const n, factor = 512, 4
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = math.Cos(2*math.Pi*10*float64(i)/n) + math.Cos(2*math.Pi*100*float64(i)/n)
+	}
+	got, err := Decimate(x, factor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != n/factor {
+		t.Fatalf("len = %d, want %d", len(got), n/factor)
+	}
+	for i, v := range got {
+		if want := math.Cos(2 * math.Pi * 10 * float64(i) / (n / factor)); math.Abs(v-want) > 1e-12 {
+			t.Fatalf("sample %d = %g, want %g from the low tone alone", i, v, want)
+		}
+	}
+
+	if got, err := Decimate(make([]float64, 10), 3); err != nil || len(got) != 3 {
+		t.Errorf("Decimate(10 samples, 3) has length %d, err %v, want 3", len(got), err)
+	}
+	for _, factor := range []int{0, -2} {
+		if _, err := Decimate(x, factor); err == nil {
+			t.Errorf("Decimate(x, %d) returned no error", factor)
+		}
+	}
+}
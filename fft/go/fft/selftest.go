@@ -0,0 +1,37 @@
+package fft
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// SelfTestTolerance is the largest per-component error SelfTest accepts.
+var SelfTestTolerance = 1e-10
+
+// SelfTest checks FFT against DFT and the IFFT round trip for every power
+// of two from 1 to 256 on fixed pseudo-random input, returning an error that
+// describes the first discrepancy above SelfTestTolerance.
+func SelfTest() error {
+	r := rand.New(rand.NewSource(1))
+	for n := 1; n <= 256; n *= 2 {
+		x := make([]Complex, n)
+		for i := range x {
+			x[i] = Complex{r.Float64()*2 - 1, r.Float64()*2 - 1}
+		}
+
+		got := FFTCopy(x)
+		want := DFT(x)
+		for k := range got {
+			if !got[k].ApproxEqual(want[k], SelfTestTolerance) {
+				return fmt.Errorf("fft: self-test: FFT of length %d gives %v at bin %d, DFT gives %v", n, got[k], k, want[k])
+			}
+		}
+		IFFT(got)
+		for i := range got {
+			if !got[i].ApproxEqual(x[i], SelfTestTolerance) {
+				return fmt.Errorf("fft: self-test: round trip of length %d gives %v at index %d, want %v", n, got[i], i, x[i])
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package fft
+
+// FFTShift moves the zero-frequency bin to the centre of the spectrum.
+func FFTShift(arr []Complex) {
+	rotate(arr, len(arr)/2)
+}
+
+// IFFTShift undoes FFTShift; the two differ only for odd lengths.
+func IFFTShift(arr []Complex) {
+	rotate(arr, len(arr)-len(arr)/2)
+}
+
+// Roll shifts arr cyclically k places to the right, or -k places to the
+// left for negative k; k is taken modulo len(arr).
+func Roll(arr []Complex, k int) {
+	n := len(arr)
+	if n == 0 {
+		return
+	}
+	rotate(arr, (k%n+n)%n)
+}
+
+// rotate shifts arr cyclically k places to the right, 0 <= k <= len(arr).
+func rotate(arr []Complex, k int) {
+	Reverse(arr)
+	Reverse(arr[:k])
+	Reverse(arr[k:])
+}
+
+// Reverse reverses arr in place.
+func Reverse(arr []Complex) {
+	for i, j := 0, len(arr)-1; i < j; i, j = i+1, j-1 {
+		arr[i], arr[j] = arr[j], arr[i]
+	}
+}
@@ -0,0 +1,78 @@
+package fft
+
+import (
+	"slices"
+	"testing"
+)
+
+func reals(arr []Complex) []float64 {
+	out := make([]float64, len(arr))
+	for i, c := range arr {
+		out[i] = c.Real
+	}
+	return out
+}
+
+func fromReals(vs ...float64) []Complex {
+	out := make([]Complex, len(vs))
+	for i, v := range vs {
+		out[i] = Complex{v, 0}
+	}
+	return out
+}
+
+func TestFFTShift(t *testing.T) {
+	tests := []struct {
+		in, shifted []float64
+	}{
+		{[]float64{0, 1, 2, 3, 4}, []float64{3, 4, 0, 1, 2}},
+		{[]float64{0, 1, 2, 3}, []float64{2, 3, 0, 1}},
+		{[]float64{7}, []float64{7}},
+		{[]float64{}, []float64{}},
+	}
+	for _, tt := range tests {
+		x := fromReals(tt.in...)
+		FFTShift(x)
+		if got := reals(x); !slices.Equal(got, tt.shifted) {
+			t.Errorf("FFTShift(%v) = %v, want %v", tt.in, got, tt.shifted)
+		}
+		IFFTShift(x)
+		if got := reals(x); !slices.Equal(got, tt.in) {
+			t.Errorf("IFFTShift(FFTShift(%v)) = %v", tt.in, got)
+		}
+	}
+}
+
+func TestRoll(t *testing.T) {
+	tests := []struct {
+		k    int
+		want []float64
+	}{
+		{0, []float64{0, 1, 2, 3, 4}},
+		{2, []float64{3, 4, 0, 1, 2}},
+		{-1, []float64{1, 2, 3, 4, 0}},
+		{7, []float64{3, 4, 0, 1, 2}},
+		{-12, []float64{2, 3, 4, 0, 1}},
+		{5, []float64{0, 1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		x := fromReals(0, 1, 2, 3, 4)
+		Roll(x, tt.k)
+		if got := reals(x); !slices.Equal(got, tt.want) {
+			t.Errorf("Roll(k=%d) = %v, want %v", tt.k, got, tt.want)
+		}
+	}
+	Roll(nil, 3) // must not divide by zero
+}
+
+func TestReverse(t *testing.T) {
+	for _, in := range [][]float64{{}, {1}, {1, 2}, {1, 2, 3, 4, 5}} {
+		x := fromReals(in...)
+		Reverse(x)
+		want := slices.Clone(in)
+		slices.Reverse(want)
+		if got := reals(x); !slices.Equal(got, want) {
+			t.Errorf("Reverse(%v) = %v, want %v", in, got, want)
+		}
+	}
+}
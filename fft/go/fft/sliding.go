@@ -0,0 +1,55 @@
+package fft
+
+import "math"
+
+// SlidingDFT maintains the orthonormal DFT of the last n samples, updating
+// every bin in O(n) per sample. The window starts as n zeros.
+//
+// Each Update adds rounding error that the recurrence never removes, so the
+// bins slowly drift from the exact transform; call Reset periodically in
+// long-running use to recompute them from the buffered samples.
+type SlidingDFT struct {
+	bins  []Complex
+	rot   []Complex // e^(2*pi*i*k/n)
+	ring  []float64
+	pos   int // index of the oldest sample
+	scale float64
+}
+
+// NewSlidingDFT returns a SlidingDFT over windows of n samples; n must be
+// positive.
+func NewSlidingDFT(n int) *SlidingDFT {
+	s := &SlidingDFT{
+		bins:  make([]Complex, n),
+		rot:   make([]Complex, n),
+		ring:  make([]float64, n),
+		scale: 1 / math.Sqrt(float64(n)),
+	}
+	for k := range s.rot {
+		s.rot[k] = FromPolar(1, 2*math.Pi*float64(k)/float64(n))
+	}
+	return s
+}
+
+// Update slides the window forward by newSample and returns the bins. The
+// returned slice is owned by s and overwritten by the next call.
+func (s *SlidingDFT) Update(newSample float64) []Complex {
+	delta := (newSample - s.ring[s.pos]) * s.scale
+	s.ring[s.pos] = newSample
+	s.pos = (s.pos + 1) % len(s.ring)
+	for k, b := range s.bins {
+		s.bins[k] = b.AddScalar(delta).Mul(s.rot[k])
+	}
+	return s.bins
+}
+
+// Reset recomputes the bins exactly from the current window, discarding
+// accumulated drift.
+func (s *SlidingDFT) Reset() {
+	n := len(s.ring)
+	for i := range s.bins {
+		s.bins[i] = Complex{s.ring[(s.pos+i)%n], 0}
+	}
+	rawTransform(s.bins)
+	scale(s.bins, s.scale)
+}
@@ -0,0 +1,73 @@
+package fft
+
+// Spectrum pairs FFT output bins with the sample rate of the signal they
+// came from.
+type Spectrum struct {
+	Bins       []Complex
+	SampleRate float64
+}
+
+// Analyze transforms a copy of signal, leaving signal untouched.
+func Analyze(signal []Complex, sampleRate float64) Spectrum {
+	return Spectrum{Bins: FFTCopy(signal), SampleRate: sampleRate}
+}
+
+func (s Spectrum) Magnitudes() []float64 {
+	out := make([]float64, len(s.Bins))
+	for i, c := range s.Bins {
+		out[i] = c.Abs()
+	}
+	return out
+}
+
+func (s Spectrum) Phases() []float64 {
+	out := make([]float64, len(s.Bins))
+	for i, c := range s.Bins {
+		out[i] = c.Phase()
+	}
+	return out
+}
+
+func (s Spectrum) Frequencies() []float64 {
+	return FreqBins(len(s.Bins), s.SampleRate)
+}
+
+// DominantFrequency returns the frequency of the strongest bin, preferring
+// the lowest-indexed bin on ties, so a real signal reports the positive
+// frequency. It is 0 for an empty spectrum.
+func (s Spectrum) DominantFrequency() float64 {
+	if len(s.Bins) == 0 {
+		return 0
+	}
+	best := 0
+	for i, c := range s.Bins {
+		if c.Abs() > s.Bins[best].Abs() {
+			best = i
+		}
+	}
+	return s.Frequencies()[best]
+}
+
+// MagPhase returns the magnitude and phase of every element of arr,
+// computed in a single pass.
+func MagPhase(arr []Complex) (mag []float64, phase []float64) {
+	mag = make([]float64, len(arr))
+	phase = make([]float64, len(arr))
+	for i, c := range arr {
+		mag[i], phase[i] = c.Abs(), c.Phase()
+	}
+	return mag, phase
+}
+
+// Whiten scales every bin of spectrum to unit magnitude in place, keeping its
+// phase; bins with magnitude at most Epsilon are set to zero. Whitening both
+// spectra before a cross-correlation gives phase-only correlation.
+func Whiten(spectrum []Complex) {
+	for i, c := range spectrum {
+		if m := c.Abs(); m > Epsilon {
+			spectrum[i] = c.MulScalar(1 / m)
+		} else {
+			spectrum[i] = Complex{}
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package fft
+
+import (
+	"fmt"
+	"math"
+)
+
+// FFTSplit is FFT over a structure-of-arrays layout: re and im hold the real
+// and imaginary parts and are transformed in place. Both must have the same
+// power-of-two length; otherwise it returns ErrLengthMismatch or
+// ErrNotPowerOfTwo and leaves them untouched.
+func FFTSplit(re, im []float64) error {
+	n := len(re)
+	if len(im) != n {
+		return fmt.Errorf("fft: %w: re has length %d, im has length %d", ErrLengthMismatch, n, len(im))
+	}
+	if n <= 1 {
+		return nil
+	}
+	if !IsPow2(n) {
+		return fmt.Errorf("fft: length %d is %w", n, ErrNotPowerOfTwo)
+	}
+	bitReverseSplit(re, im)
+
+	// the first stage has only the twiddle 1, so it needs no multiplies
+	for i := 0; i < n; i += 2 {
+		re[i], re[i+1] = re[i]+re[i+1], re[i]-re[i+1]
+		im[i], im[i+1] = im[i]+im[i+1], im[i]-im[i+1]
+	}
+
+	tw := cachedTwiddles(n)
+	for size := 4; size <= n; size <<= 1 {
+		half := size / 2
+		step := n / size
+		for start := 0; start < n; start += size {
+			r0, i0 := re[start:start+half], im[start:start+half]
+			r1, i1 := re[start+half:start+size], im[start+half:start+size]
+			for k := range r0 {
+				w := tw[k*step]
+				qr := w.Real*r1[k] - w.Imag*i1[k]
+				qi := w.Real*i1[k] + w.Imag*r1[k]
+				r1[k], i1[k] = r0[k]-qr, i0[k]-qi
+				r0[k], i0[k] = r0[k]+qr, i0[k]+qi
+			}
+		}
+	}
+
+	s := 1 / math.Sqrt(float64(n))
+	for i := range re {
+		re[i] *= s
+		im[i] *= s
+	}
+	return nil
+}
+
+// bitReverseSplit is BitReverse applied to re and im together.
+func bitReverseSplit(re, im []float64) {
+	n := len(re)
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+		if i < j {
+			re[i], re[j] = re[j], re[i]
+			im[i], im[j] = im[j], im[i]
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package fft
+
+import "fmt"
+
+// FFTSplitRadix is FFT computed with the split-radix algorithm, which needs
+// fewer multiplications than radix-2. len(arr) must be a power of two; other
+// lengths return ErrNotPowerOfTwo and leave arr untouched.
+func FFTSplitRadix(arr []Complex) error {
+	n := len(arr)
+	if n <= 1 {
+		return nil
+	}
+	if !IsPow2(n) {
+		return fmt.Errorf("fft: length %d is %w", n, ErrNotPowerOfTwo)
+	}
+	scratch := getScratch[float64](n)
+	splitRadix(arr, *scratch, cachedTwiddles(n))
+	putScratch(scratch)
+	normalize(arr)
+	return nil
+}
+
+// splitRadix combines a half-length transform of the even samples with two
+// quarter-length transforms of the samples at 4j+1 and 4j+3. Like
+// fftScratch it gathers them into scratch and recurses with the matching
+// parts of arr as their scratch; tw is the top-level twiddle table.
+func splitRadix(arr, scratch, tw []Complex) {
+	n := len(arr)
+	switch n {
+	case 1:
+		return
+	case 2:
+		arr[0], arr[1] = arr[0].Add(arr[1]), arr[0].Sub(arr[1])
+		return
+	case 4:
+		fft4(arr, -1)
+		return
+	}
+
+	u, z1, z3 := scratch[:n/2], scratch[n/2:3*n/4], scratch[3*n/4:n]
+	for i := range n / 4 {
+		u[2*i] = arr[4*i]
+		z1[i] = arr[4*i+1]
+		u[2*i+1] = arr[4*i+2]
+		z3[i] = arr[4*i+3]
+	}
+	splitRadix(u, arr[:n/2], tw)
+	splitRadix(z1, arr[n/2:3*n/4], tw)
+	splitRadix(z3, arr[3*n/4:], tw)
+
+	stride := 2 * len(tw) / n
+	for k := range n / 4 {
+		a := tw[k*stride].Mul(z1[k])
+		b := twiddle(tw, 3*k*stride).Mul(z3[k])
+		sum := a.Add(b)
+		rot := a.Sub(b).MulI().Neg()
+		arr[k] = u[k].Add(sum)
+		arr[k+n/2] = u[k].Sub(sum)
+		arr[k+n/4] = u[k+n/4].Add(rot)
+		arr[k+3*n/4] = u[k+n/4].Sub(rot)
+	}
+}
+
+// twiddle returns W^j from a table tw of the first n/2 powers of W, using
+// W^(j+n/2) = -W^j for the j < n the half table does not hold.
+func twiddle(tw []Complex, j int) Complex {
+	if j < len(tw) {
+		return tw[j]
+	}
+	return tw[j-len(tw)].Neg()
+}
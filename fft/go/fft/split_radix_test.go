@@ -0,0 +1,39 @@
+package fft
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFFTSplitRadixMatchesFFT(t *testing.T) {
+	for _, n := range []int{1, 2, 4, 8, 32, 1024} {
+		x := randomSignal(n, int64(n))
+		want := Clone(x)
+		FFT(want)
+		if err := FFTSplitRadix(x); err != nil {
+			t.Fatal(err)
+		}
+		if d := maxDiff(x, want); d > 1e-12 {
+			t.Errorf("n=%d: FFTSplitRadix differs from FFT by %g", n, d)
+		}
+	}
+}
+
+func TestFFTSplitRadixRejectsNonPowerOfTwo(t *testing.T) {
+	x := randomSignal(6, 1)
+	want := Clone(x)
+	if err := FFTSplitRadix(x); !errors.Is(err, ErrNotPowerOfTwo) {
+		t.Errorf("n=6: err = %v, want ErrNotPowerOfTwo", err)
+	}
+	if d := maxDiff(x, want); d != 0 {
+		t.Error("rejected input was modified")
+	}
+}
+
+// BenchmarkFFTSplitRadix compares split-radix with the radix-2 FFT it is
+// meant to beat, at large sizes.
+func BenchmarkFFTSplitRadix(b *testing.B) {
+	benchCompare(b, []int{16, 20},
+		namedTransform{"FFT", FFT[float64]},
+		namedTransform{"FFTSplitRadix", func(arr []Complex) { FFTSplitRadix(arr) }})
+}
@@ -0,0 +1,61 @@
+package fft
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFFTSplitMatchesFFT(t *testing.T) {
+	for _, n := range []int{1, 2, 4, 8, 64, 1024} {
+		x := randomSignal(n, int64(n))
+		re, im := make([]float64, n), make([]float64, n)
+		for i, c := range x {
+			re[i], im[i] = c.Real, c.Imag
+		}
+		if err := FFTSplit(re, im); err != nil {
+			t.Fatalf("n=%d: %v", n, err)
+		}
+		FFT(x)
+		got := make([]Complex, n)
+		for i := range got {
+			got[i] = Complex{re[i], im[i]}
+		}
+		if d := maxDiff(got, x); d > 1e-12 {
+			t.Errorf("n=%d: FFTSplit differs from FFT by %g", n, d)
+		}
+	}
+}
+
+func TestFFTSplitValidation(t *testing.T) {
+	if err := FFTSplit(make([]float64, 8), make([]float64, 4)); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("mismatched lengths: err = %v, want ErrLengthMismatch", err)
+	}
+	re, im := []float64{1, 2, 3}, []float64{4, 5, 6}
+	if err := FFTSplit(re, im); !errors.Is(err, ErrNotPowerOfTwo) {
+		t.Errorf("length 3: err = %v, want ErrNotPowerOfTwo", err)
+	}
+	if re[0] != 1 || im[2] != 6 {
+		t.Error("FFTSplit modified its input on error")
+	}
+}
+
+func BenchmarkFFTSplit(b *testing.B) {
+	const n = 1 << 20
+	src := randomSignal(n, 1)
+	b.Run("soa", func(b *testing.B) {
+		re, im := make([]float64, n), make([]float64, n)
+		for b.Loop() {
+			for i, c := range src {
+				re[i], im[i] = c.Real, c.Imag
+			}
+			FFTSplit(re, im)
+		}
+	})
+	b.Run("aos", func(b *testing.B) {
+		arr := make([]Complex, n)
+		for b.Loop() {
+			copy(arr, src)
+			FFT(arr)
+		}
+	})
+}
@@ -0,0 +1,33 @@
+package fft
+
+import "fmt"
+
+// STFT transforms frames of frameSize samples taken every hop samples,
+// zero-padding the final frame if it runs past the end of signal. A nil
+// window leaves the frames unweighted.
+func STFT(signal []Complex, frameSize, hop int, window []float64) ([][]Complex, error) {
+	if !IsPow2(frameSize) {
+		return nil, fmt.Errorf("fft: frame size %d is %w", frameSize, ErrNotPowerOfTwo)
+	}
+	if hop <= 0 {
+		return nil, fmt.Errorf("fft: hop %d must be positive", hop)
+	}
+	if window != nil && len(window) != frameSize {
+		return nil, fmt.Errorf("fft: %w: window length %d, frame size %d", ErrLengthMismatch, len(window), frameSize)
+	}
+
+	var frames [][]Complex
+	for start := 0; start < len(signal); start += hop {
+		frame := make([]Complex, frameSize)
+		copy(frame, signal[start:])
+		if window != nil {
+			ApplyWindow(frame, window)
+		}
+		FFT(frame)
+		frames = append(frames, frame)
+		if start+frameSize >= len(signal) {
+			break
+		}
+	}
+	return frames, nil
+}
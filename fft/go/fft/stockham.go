@@ -0,0 +1,32 @@
+package fft
+
+// FFTStockham ping-pongs between arr and one extra buffer so that the
+// output lands in natural order without a bit-reversal pass.
+func FFTStockham(arr []Complex) {
+	n := len(arr)
+	if n <= 1 {
+		return
+	}
+	stockham(arr, make([]Complex, n), twiddles(n, -1))
+	normalize(arr)
+}
+
+func stockham(arr, buf, tw []Complex) {
+	n := len(arr)
+	x, y := arr, buf
+	for l, m := n/2, 1; l >= 1; l, m = l/2, m*2 {
+		for j := range l {
+			w := tw[j*m]
+			for k := range m {
+				c0 := x[k+j*m]
+				c1 := x[k+j*m+l*m]
+				y[k+2*j*m] = c0.Add(c1)
+				y[k+2*j*m+m] = w.Mul(c0.Sub(c1))
+			}
+		}
+		x, y = y, x
+	}
+	if &x[0] != &arr[0] {
+		copy(arr, x)
+	}
+}
@@ -0,0 +1,23 @@
+package fft
+
+import "testing"
+
+func TestFFTStockhamMatchesFFT(t *testing.T) {
+	for _, n := range []int{2, 4, 8, 64, 1024, 1 << 14} {
+		x := randomSignal(n, int64(n))
+		want := Clone(x)
+		FFT(want)
+		FFTStockham(x)
+		if d := maxDiff(x, want); d > 1e-12 {
+			t.Errorf("n=%d: FFTStockham differs from FFT by %g", n, d)
+		}
+	}
+}
+
+// BenchmarkFFTStockham weighs Stockham's extra buffer against the
+// bit-reversal pass of FFTIterative at 2^20.
+func BenchmarkFFTStockham(b *testing.B) {
+	benchCompare(b, []int{20},
+		namedTransform{"FFTIterative", func(arr []Complex) { FFTIterative(arr) }},
+		namedTransform{"FFTStockham", FFTStockham})
+}
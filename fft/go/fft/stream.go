@@ -0,0 +1,70 @@
+package fft
+
+import "fmt"
+
+// Streamer is an incremental STFT. It keeps the most recent frameSize
+// samples in a ring buffer and transforms a frame each time hop new samples
+// have arrived, starting once the first full frame is buffered.
+type Streamer struct {
+	plan   *Plan
+	window []float64
+	hop    int
+	ring   []float64
+	pos    int // index of the oldest sample, and the next write
+	due    int // samples still needed before the next frame
+}
+
+// NewStreamer returns a Streamer emitting frames of frameSize samples every
+// hop samples. A nil window leaves the frames unweighted.
+func NewStreamer(frameSize, hop int, window []float64) (*Streamer, error) {
+	if !IsPow2(frameSize) {
+		return nil, fmt.Errorf("fft: frame size %d is %w", frameSize, ErrNotPowerOfTwo)
+	}
+	if hop <= 0 {
+		return nil, fmt.Errorf("fft: hop %d must be positive", hop)
+	}
+	if window != nil && len(window) != frameSize {
+		return nil, fmt.Errorf("fft: %w: window length %d, frame size %d", ErrLengthMismatch, len(window), frameSize)
+	}
+	plan, err := NewPlan(frameSize)
+	if err != nil {
+		return nil, err
+	}
+	return &Streamer{
+		plan:   plan,
+		window: window,
+		hop:    hop,
+		ring:   make([]float64, frameSize),
+		due:    frameSize,
+	}, nil
+}
+
+// Push appends samples to the stream and returns the spectra of the frames
+// completed by them, oldest first. It returns nil if no frame completed.
+func (s *Streamer) Push(samples []float64) [][]Complex {
+	var frames [][]Complex
+	for _, x := range samples {
+		s.ring[s.pos] = x
+		s.pos = (s.pos + 1) % len(s.ring)
+		s.due--
+		if s.due == 0 {
+			frames = append(frames, s.frame())
+			s.due = s.hop
+		}
+	}
+	return frames
+}
+
+// frame transforms the buffered samples in arrival order.
+func (s *Streamer) frame() []Complex {
+	n := len(s.ring)
+	frame := make([]Complex, n)
+	for i := range frame {
+		frame[i].Real = s.ring[(s.pos+i)%n]
+		if s.window != nil {
+			frame[i].Real *= s.window[i]
+		}
+	}
+	s.plan.FFT(frame)
+	return frame
+}
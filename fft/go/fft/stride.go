@@ -0,0 +1,57 @@
+package fft
+
+import (
+	"fmt"
+	"math"
+)
+
+// FFTStride transforms in place the n elements arr[offset], arr[offset+stride],
+// ..., as if they had been copied out, transformed with FFT and written back.
+// It lets one channel of interleaved data be transformed without a copy.
+func FFTStride(arr []Complex, offset, stride, n int) error {
+	if n == 0 {
+		return nil
+	}
+	if !IsPow2(n) {
+		return fmt.Errorf("fft: length %d is %w", n, ErrNotPowerOfTwo)
+	}
+	if stride <= 0 {
+		return fmt.Errorf("fft: stride %d must be positive", stride)
+	}
+	if offset < 0 || offset+(n-1)*stride >= len(arr) {
+		return fmt.Errorf("fft: %w: %d elements with offset %d and stride %d exceed length %d", ErrLengthMismatch, n, offset, stride, len(arr))
+	}
+
+	at := func(i int) *Complex { return &arr[offset+i*stride] }
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+		if i < j {
+			*at(i), *at(j) = *at(j), *at(i)
+		}
+	}
+
+	tw := cachedTwiddles(n)
+	for size := 2; size <= n; size <<= 1 {
+		half := size / 2
+		step := n / size
+		for start := 0; start < n; start += size {
+			for k := range half {
+				a, b := at(start+k), at(start+k+half)
+				p := *a
+				q := tw[k*step].Mul(*b)
+				*a = p.Add(q)
+				*b = p.Sub(q)
+			}
+		}
+	}
+
+	s := 1 / math.Sqrt(float64(n))
+	for i := range n {
+		*at(i) = at(i).MulScalar(s)
+	}
+	return nil
+}
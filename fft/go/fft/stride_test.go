@@ -0,0 +1,72 @@
+package fft
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFFTStrideMatchesGatherFFTScatter(t *testing.T) {
+	for _, tt := range []struct{ offset, stride, n int }{
+		{0, 1, 16},
+		{0, 2, 8},
+		{1, 2, 8},
+		{2, 3, 32},
+		{5, 7, 1},
+		{0, 4, 64},
+	} {
+		orig := randomSignal(tt.offset+tt.n*tt.stride+3, int64(tt.n+tt.stride))
+		got := append([]Complex(nil), orig...)
+		if err := FFTStride(got, tt.offset, tt.stride, tt.n); err != nil {
+			t.Fatalf("%+v: %v", tt, err)
+		}
+
+		want := append([]Complex(nil), orig...)
+		channel := make([]Complex, tt.n)
+		for k := range channel {
+			channel[k] = want[tt.offset+k*tt.stride]
+		}
+		FFT(channel)
+		for k, v := range channel {
+			want[tt.offset+k*tt.stride] = v
+		}
+
+		for i := range got {
+			inStride := i >= tt.offset && (i-tt.offset)%tt.stride == 0 && (i-tt.offset)/tt.stride < tt.n
+			if !inStride && got[i] != orig[i] {
+				t.Errorf("%+v: element %d outside the stride changed", tt, i)
+			}
+		}
+		if d := maxDiff(got, want); d > 1e-12 {
+			t.Errorf("%+v: max diff from gather, FFT, scatter %g", tt, d)
+		}
+	}
+}
+
+func TestFFTStrideValidation(t *testing.T) {
+	arr := randomSignal(16, 1)
+	orig := append([]Complex(nil), arr...)
+	tests := []struct {
+		name              string
+		offset, stride, n int
+		want              error
+	}{
+		{"n not a power of two", 0, 1, 6, ErrNotPowerOfTwo},
+		{"zero stride", 0, 0, 4, nil},
+		{"negative stride", 0, -1, 4, nil},
+		{"negative offset", -1, 1, 4, ErrLengthMismatch},
+		{"past the end", 2, 2, 8, ErrLengthMismatch},
+		{"offset past the end", 16, 1, 1, ErrLengthMismatch},
+	}
+	for _, tt := range tests {
+		err := FFTStride(arr, tt.offset, tt.stride, tt.n)
+		if err == nil || tt.want != nil && !errors.Is(err, tt.want) {
+			t.Errorf("%s: err = %v, want %v", tt.name, err, tt.want)
+		}
+		if maxDiff(arr, orig) != 0 {
+			t.Fatalf("%s: arr modified on error", tt.name)
+		}
+	}
+	if err := FFTStride(arr, 3, 5, 0); err != nil || maxDiff(arr, orig) != 0 {
+		t.Errorf("n = 0: err = %v, arr modified = %v", err, maxDiff(arr, orig) != 0)
+	}
+}
@@ -0,0 +1,4086 @@
+// Code generated by gen_twiddles.go; DO NOT EDIT.
+
+package fft
+
+// bakedTwiddles holds twiddles(n, -1) for n = 64 ... 4096, indexed by
+// log2(n).
+var bakedTwiddles = [...][]Complex{
+	6: {
+		{1, 0},
+		{0.9951847266721968, -0.0980171403295606},
+		{0.9807852804032304, -0.19509032201612825},
+		{0.9569403357322088, -0.29028467725446233},
+		{0.9238795325112867, -0.3826834323650898},
+		{0.881921264348355, -0.47139673682599764},
+		{0.8314696123025453, -0.5555702330196022},
+		{0.773010453362737, -0.6343932841636455},
+		{0.7071067811865476, -0.7071067811865475},
+		{0.6343932841636456, -0.7730104533627369},
+		{0.5555702330196023, -0.8314696123025452},
+		{0.4713967368259978, -0.8819212643483549},
+		{0.38268343236508984, -0.9238795325112867},
+		{0.2902846772544623, -0.9569403357322089},
+		{0.1950903220161283, -0.9807852804032304},
+		{0.09801714032956077, -0.9951847266721968},
+		{0, -1},
+		{-0.0980171403295606, -0.9951847266721968},
+		{-0.19509032201612825, -0.9807852804032304},
+		{-0.29028467725446233, -0.9569403357322088},
+		{-0.3826834323650898, -0.9238795325112867},
+		{-0.47139673682599764, -0.881921264348355},
+		{-0.5555702330196022, -0.8314696123025453},
+		{-0.6343932841636455, -0.773010453362737},
+		{-0.7071067811865475, -0.7071067811865476},
+		{-0.7730104533627369, -0.6343932841636456},
+		{-0.8314696123025452, -0.5555702330196023},
+		{-0.8819212643483549, -0.4713967368259978},
+		{-0.9238795325112867, -0.38268343236508984},
+		{-0.9569403357322089, -0.2902846772544623},
+		{-0.9807852804032304, -0.1950903220161283},
+		{-0.9951847266721968, -0.09801714032956077},
+	},
+	7: {
+		{1, 0},
+		{0.9987954562051724, -0.049067674327418015},
+		{0.9951847266721968, -0.0980171403295606},
+		{0.989176509964781, -0.14673047445536175},
+		{0.9807852804032304, -0.19509032201612825},
+		{0.9700312531945441, -0.24298017990326387},
+		{0.9569403357322088, -0.29028467725446233},
+		{0.9415440651830208, -0.33688985339222005},
+		{0.9238795325112867, -0.3826834323650898},
+		{0.9039892931234433, -0.4275550934302821},
+		{0.881921264348355, -0.47139673682599764},
+		{0.8577286100002721, -0.5141027441932217},
+		{0.8314696123025453, -0.5555702330196022},
+		{0.8032075314806449, -0.5956993044924334},
+		{0.773010453362737, -0.6343932841636455},
+		{0.7409511253549591, -0.6715589548470183},
+		{0.7071067811865476, -0.7071067811865475},
+		{0.6715589548470184, -0.7409511253549591},
+		{0.6343932841636456, -0.7730104533627369},
+		{0.5956993044924335, -0.8032075314806448},
+		{0.5555702330196023, -0.8314696123025452},
+		{0.5141027441932218, -0.8577286100002721},
+		{0.4713967368259978, -0.8819212643483549},
+		{0.4275550934302822, -0.9039892931234433},
+		{0.38268343236508984, -0.9238795325112867},
+		{0.33688985339222005, -0.9415440651830208},
+		{0.2902846772544623, -0.9569403357322089},
+		{0.24298017990326398, -0.970031253194544},
+		{0.1950903220161283, -0.9807852804032304},
+		{0.14673047445536175, -0.989176509964781},
+		{0.09801714032956077, -0.9951847266721968},
+		{0.04906767432741813, -0.9987954562051724},
+		{0, -1},
+		{-0.049067674327418015, -0.9987954562051724},
+		{-0.0980171403295606, -0.9951847266721968},
+		{-0.14673047445536175, -0.989176509964781},
+		{-0.19509032201612825, -0.9807852804032304},
+		{-0.24298017990326387, -0.9700312531945441},
+		{-0.29028467725446233, -0.9569403357322088},
+		{-0.33688985339222005, -0.9415440651830208},
+		{-0.3826834323650898, -0.9238795325112867},
+		{-0.4275550934302821, -0.9039892931234433},
+		{-0.47139673682599764, -0.881921264348355},
+		{-0.5141027441932217, -0.8577286100002721},
+		{-0.5555702330196022, -0.8314696123025453},
+		{-0.5956993044924334, -0.8032075314806449},
+		{-0.6343932841636455, -0.773010453362737},
+		{-0.6715589548470183, -0.7409511253549591},
+		{-0.7071067811865475, -0.7071067811865476},
+		{-0.7409511253549591, -0.6715589548470184},
+		{-0.7730104533627369, -0.6343932841636456},
+		{-0.8032075314806448, -0.5956993044924335},
+		{-0.8314696123025452, -0.5555702330196023},
+		{-0.8577286100002721, -0.5141027441932218},
+		{-0.8819212643483549, -0.4713967368259978},
+		{-0.9039892931234433, -0.4275550934302822},
+		{-0.9238795325112867, -0.38268343236508984},
+		{-0.9415440651830208, -0.33688985339222005},
+		{-0.9569403357322089, -0.2902846772544623},
+		{-0.970031253194544, -0.24298017990326398},
+		{-0.9807852804032304, -0.1950903220161283},
+		{-0.989176509964781, -0.14673047445536175},
+		{-0.9951847266721968, -0.09801714032956077},
+		{-0.9987954562051724, -0.04906767432741813},
+	},
+	8: {
+		{1, 0},
+		{0.9996988186962041, -0.024541228522912288},
+		{0.9987954562051724, -0.049067674327418015},
+		{0.9972904566786902, -0.07356456359966743},
+		{0.9951847266721968, -0.0980171403295606},
+		{0.9924795345987101, -0.1224106751992162},
+		{0.989176509964781, -0.14673047445536175},
+		{0.9852776423889412, -0.17096188876030122},
+		{0.9807852804032304, -0.19509032201612825},
+		{0.9757021300385286, -0.2191012401568698},
+		{0.9700312531945441, -0.24298017990326387},
+		{0.9637760657954398, -0.26671275747489837},
+		{0.9569403357322088, -0.29028467725446233},
+		{0.9495281805930367, -0.3136817403988915},
+		{0.9415440651830208, -0.33688985339222005},
+		{0.9329927988347388, -0.3598950365349881},
+		{0.9238795325112867, -0.3826834323650898},
+		{0.9142097557035307, -0.40524131400498986},
+		{0.9039892931234433, -0.4275550934302821},
+		{0.8932243011955153, -0.44961132965460654},
+		{0.881921264348355, -0.47139673682599764},
+		{0.8700869911087113, -0.49289819222978404},
+		{0.8577286100002721, -0.5141027441932217},
+		{0.8448535652497071, -0.5349976198870972},
+		{0.8314696123025453, -0.5555702330196022},
+		{0.8175848131515837, -0.5758081914178453},
+		{0.8032075314806449, -0.5956993044924334},
+		{0.7883464276266063, -0.6152315905806268},
+		{0.773010453362737, -0.6343932841636455},
+		{0.7572088465064846, -0.6531728429537768},
+		{0.7409511253549591, -0.6715589548470183},
+		{0.724247082951467, -0.6895405447370668},
+		{0.7071067811865476, -0.7071067811865475},
+		{0.6895405447370669, -0.7242470829514668},
+		{0.6715589548470184, -0.7409511253549591},
+		{0.6531728429537769, -0.7572088465064845},
+		{0.6343932841636456, -0.7730104533627369},
+		{0.6152315905806269, -0.7883464276266062},
+		{0.5956993044924335, -0.8032075314806448},
+		{0.5758081914178454, -0.8175848131515836},
+		{0.5555702330196023, -0.8314696123025452},
+		{0.5349976198870974, -0.844853565249707},
+		{0.5141027441932218, -0.8577286100002721},
+		{0.49289819222978415, -0.8700869911087113},
+		{0.4713967368259978, -0.8819212643483549},
+		{0.4496113296546066, -0.8932243011955153},
+		{0.4275550934302822, -0.9039892931234433},
+		{0.4052413140049898, -0.9142097557035307},
+		{0.38268343236508984, -0.9238795325112867},
+		{0.3598950365349883, -0.9329927988347387},
+		{0.33688985339222005, -0.9415440651830208},
+		{0.3136817403988915, -0.9495281805930367},
+		{0.2902846772544623, -0.9569403357322089},
+		{0.2667127574748984, -0.9637760657954398},
+		{0.24298017990326398, -0.970031253194544},
+		{0.21910124015686977, -0.9757021300385286},
+		{0.1950903220161283, -0.9807852804032304},
+		{0.17096188876030136, -0.9852776423889412},
+		{0.14673047445536175, -0.989176509964781},
+		{0.12241067519921628, -0.9924795345987101},
+		{0.09801714032956077, -0.9951847266721968},
+		{0.07356456359966745, -0.9972904566786902},
+		{0.04906767432741813, -0.9987954562051724},
+		{0.024541228522912267, -0.9996988186962041},
+		{0, -1},
+		{-0.024541228522912288, -0.9996988186962041},
+		{-0.049067674327418015, -0.9987954562051724},
+		{-0.07356456359966743, -0.9972904566786902},
+		{-0.0980171403295606, -0.9951847266721968},
+		{-0.1224106751992162, -0.9924795345987101},
+		{-0.14673047445536175, -0.989176509964781},
+		{-0.17096188876030122, -0.9852776423889412},
+		{-0.19509032201612825, -0.9807852804032304},
+		{-0.2191012401568698, -0.9757021300385286},
+		{-0.24298017990326387, -0.9700312531945441},
+		{-0.26671275747489837, -0.9637760657954398},
+		{-0.29028467725446233, -0.9569403357322088},
+		{-0.3136817403988915, -0.9495281805930367},
+		{-0.33688985339222005, -0.9415440651830208},
+		{-0.3598950365349881, -0.9329927988347388},
+		{-0.3826834323650898, -0.9238795325112867},
+		{-0.40524131400498986, -0.9142097557035307},
+		{-0.4275550934302821, -0.9039892931234433},
+		{-0.44961132965460654, -0.8932243011955153},
+		{-0.47139673682599764, -0.881921264348355},
+		{-0.49289819222978404, -0.8700869911087113},
+		{-0.5141027441932217, -0.8577286100002721},
+		{-0.5349976198870972, -0.8448535652497071},
+		{-0.5555702330196022, -0.8314696123025453},
+		{-0.5758081914178453, -0.8175848131515837},
+		{-0.5956993044924334, -0.8032075314806449},
+		{-0.6152315905806268, -0.7883464276266063},
+		{-0.6343932841636455, -0.773010453362737},
+		{-0.6531728429537768, -0.7572088465064846},
+		{-0.6715589548470183, -0.7409511253549591},
+		{-0.6895405447370668, -0.724247082951467},
+		{-0.7071067811865475, -0.7071067811865476},
+		{-0.7242470829514668, -0.6895405447370669},
+		{-0.7409511253549591, -0.6715589548470184},
+		{-0.7572088465064845, -0.6531728429537769},
+		{-0.7730104533627369, -0.6343932841636456},
+		{-0.7883464276266062, -0.6152315905806269},
+		{-0.8032075314806448, -0.5956993044924335},
+		{-0.8175848131515836, -0.5758081914178454},
+		{-0.8314696123025452, -0.5555702330196023},
+		{-0.844853565249707, -0.5349976198870974},
+		{-0.8577286100002721, -0.5141027441932218},
+		{-0.8700869911087113, -0.49289819222978415},
+		{-0.8819212643483549, -0.4713967368259978},
+		{-0.8932243011955153, -0.4496113296546066},
+		{-0.9039892931234433, -0.4275550934302822},
+		{-0.9142097557035307, -0.4052413140049898},
+		{-0.9238795325112867, -0.38268343236508984},
+		{-0.9329927988347387, -0.3598950365349883},
+		{-0.9415440651830208, -0.33688985339222005},
+		{-0.9495281805930367, -0.3136817403988915},
+		{-0.9569403357322089, -0.2902846772544623},
+		{-0.9637760657954398, -0.2667127574748984},
+		{-0.970031253194544, -0.24298017990326398},
+		{-0.9757021300385286, -0.21910124015686977},
+		{-0.9807852804032304, -0.1950903220161283},
+		{-0.9852776423889412, -0.17096188876030136},
+		{-0.989176509964781, -0.14673047445536175},
+		{-0.9924795345987101, -0.12241067519921628},
+		{-0.9951847266721968, -0.09801714032956077},
+		{-0.9972904566786902, -0.07356456359966745},
+		{-0.9987954562051724, -0.04906767432741813},
+		{-0.9996988186962041, -0.024541228522912267},
+	},
+	9: {
+		{1, 0},
+		{0.9999247018391446, -0.012271538285719925},
+		{0.9996988186962041, -0.024541228522912288},
+		{0.9993223845883494, -0.03680722294135883},
+		{0.9987954562051724, -0.049067674327418015},
+		{0.9981181129001492, -0.06132073630220858},
+		{0.9972904566786902, -0.07356456359966743},
+		{0.996312612182778, -0.0857973123444399},
+		{0.9951847266721968, -0.0980171403295606},
+		{0.9939069700023561, -0.11022220729388306},
+		{0.9924795345987101, -0.1224106751992162},
+		{0.99090263542778, -0.13458070850712617},
+		{0.989176509964781, -0.14673047445536175},
+		{0.9873014181578584, -0.15885814333386145},
+		{0.9852776423889412, -0.17096188876030122},
+		{0.9831054874312164, -0.18303988795514095},
+		{0.9807852804032304, -0.19509032201612825},
+		{0.9783173707196277, -0.20711137619221856},
+		{0.9757021300385286, -0.2191012401568698},
+		{0.9729399522055602, -0.2310581082806711},
+		{0.9700312531945441, -0.24298017990326387},
+		{0.9669764710448521, -0.25486565960451457},
+		{0.9637760657954398, -0.26671275747489837},
+		{0.9604305194155658, -0.27851968938505306},
+		{0.9569403357322088, -0.29028467725446233},
+		{0.9533060403541938, -0.3020059493192281},
+		{0.9495281805930367, -0.3136817403988915},
+		{0.9456073253805213, -0.3253102921622629},
+		{0.9415440651830208, -0.33688985339222005},
+		{0.937339011912575, -0.34841868024943456},
+		{0.9329927988347388, -0.3598950365349881},
+		{0.9285060804732156, -0.37131719395183754},
+		{0.9238795325112867, -0.3826834323650898},
+		{0.9191138516900577, -0.3939920400610481},
+		{0.9142097557035307, -0.40524131400498986},
+		{0.9091679830905225, -0.41642956009763715},
+		{0.9039892931234433, -0.4275550934302821},
+		{0.8986744656939538, -0.43861623853852766},
+		{0.8932243011955153, -0.44961132965460654},
+		{0.8876396204028539, -0.46053871095824},
+		{0.881921264348355, -0.47139673682599764},
+		{0.8760700941954066, -0.4821837720791227},
+		{0.8700869911087113, -0.49289819222978404},
+		{0.8639728561215867, -0.5035383837257176},
+		{0.8577286100002721, -0.5141027441932217},
+		{0.8513551931052652, -0.524589682678469},
+		{0.8448535652497071, -0.5349976198870972},
+		{0.8382247055548381, -0.5453249884220465},
+		{0.8314696123025453, -0.5555702330196022},
+		{0.8245893027850253, -0.5657318107836131},
+		{0.8175848131515837, -0.5758081914178453},
+		{0.8104571982525948, -0.5857978574564389},
+		{0.8032075314806449, -0.5956993044924334},
+		{0.7958369046088836, -0.6055110414043255},
+		{0.7883464276266063, -0.6152315905806268},
+		{0.7807372285720945, -0.6248594881423863},
+		{0.773010453362737, -0.6343932841636455},
+		{0.765167265622459, -0.6438315428897914},
+		{0.7572088465064846, -0.6531728429537768},
+		{0.7491363945234594, -0.6624157775901718},
+		{0.7409511253549591, -0.6715589548470183},
+		{0.7326542716724129, -0.680600997795453},
+		{0.724247082951467, -0.6895405447370668},
+		{0.7157308252838186, -0.6983762494089729},
+		{0.7071067811865476, -0.7071067811865475},
+		{0.6983762494089729, -0.7157308252838186},
+		{0.6895405447370669, -0.7242470829514668},
+		{0.6806009977954531, -0.7326542716724128},
+		{0.6715589548470184, -0.7409511253549591},
+		{0.6624157775901718, -0.7491363945234593},
+		{0.6531728429537769, -0.7572088465064845},
+		{0.6438315428897915, -0.765167265622459},
+		{0.6343932841636456, -0.7730104533627369},
+		{0.6248594881423865, -0.7807372285720944},
+		{0.6152315905806269, -0.7883464276266062},
+		{0.6055110414043255, -0.7958369046088835},
+		{0.5956993044924335, -0.8032075314806448},
+		{0.5857978574564389, -0.8104571982525947},
+		{0.5758081914178454, -0.8175848131515836},
+		{0.5657318107836132, -0.8245893027850253},
+		{0.5555702330196023, -0.8314696123025452},
+		{0.5453249884220466, -0.838224705554838},
+		{0.5349976198870974, -0.844853565249707},
+		{0.524589682678469, -0.8513551931052652},
+		{0.5141027441932218, -0.8577286100002721},
+		{0.5035383837257176, -0.8639728561215866},
+		{0.49289819222978415, -0.8700869911087113},
+		{0.4821837720791229, -0.8760700941954065},
+		{0.4713967368259978, -0.8819212643483549},
+		{0.46053871095824, -0.8876396204028539},
+		{0.4496113296546066, -0.8932243011955153},
+		{0.4386162385385277, -0.8986744656939538},
+		{0.4275550934302822, -0.9039892931234433},
+		{0.4164295600976373, -0.9091679830905224},
+		{0.4052413140049898, -0.9142097557035307},
+		{0.3939920400610481, -0.9191138516900577},
+		{0.38268343236508984, -0.9238795325112867},
+		{0.3713171939518376, -0.9285060804732156},
+		{0.3598950365349883, -0.9329927988347387},
+		{0.3484186802494345, -0.937339011912575},
+		{0.33688985339222005, -0.9415440651830208},
+		{0.325310292162263, -0.9456073253805213},
+		{0.3136817403988915, -0.9495281805930367},
+		{0.3020059493192282, -0.9533060403541938},
+		{0.2902846772544623, -0.9569403357322089},
+		{0.27851968938505306, -0.9604305194155658},
+		{0.2667127574748984, -0.9637760657954398},
+		{0.2548656596045146, -0.9669764710448521},
+		{0.24298017990326398, -0.970031253194544},
+		{0.23105810828067125, -0.9729399522055602},
+		{0.21910124015686977, -0.9757021300385286},
+		{0.20711137619221856, -0.9783173707196277},
+		{0.1950903220161283, -0.9807852804032304},
+		{0.18303988795514103, -0.9831054874312164},
+		{0.17096188876030136, -0.9852776423889412},
+		{0.1588581433338614, -0.9873014181578584},
+		{0.14673047445536175, -0.989176509964781},
+		{0.13458070850712622, -0.99090263542778},
+		{0.12241067519921628, -0.9924795345987101},
+		{0.11022220729388318, -0.9939069700023561},
+		{0.09801714032956077, -0.9951847266721968},
+		{0.08579731234443988, -0.996312612182778},
+		{0.07356456359966745, -0.9972904566786902},
+		{0.061320736302208655, -0.9981181129001492},
+		{0.04906767432741813, -0.9987954562051724},
+		{0.03680722294135899, -0.9993223845883494},
+		{0.024541228522912267, -0.9996988186962041},
+		{0.012271538285719944, -0.9999247018391446},
+		{0, -1},
+		{-0.012271538285719925, -0.9999247018391446},
+		{-0.024541228522912288, -0.9996988186962041},
+		{-0.03680722294135883, -0.9993223845883494},
+		{-0.049067674327418015, -0.9987954562051724},
+		{-0.06132073630220858, -0.9981181129001492},
+		{-0.07356456359966743, -0.9972904566786902},
+		{-0.0857973123444399, -0.996312612182778},
+		{-0.0980171403295606, -0.9951847266721968},
+		{-0.11022220729388306, -0.9939069700023561},
+		{-0.1224106751992162, -0.9924795345987101},
+		{-0.13458070850712617, -0.99090263542778},
+		{-0.14673047445536175, -0.989176509964781},
+		{-0.15885814333386145, -0.9873014181578584},
+		{-0.17096188876030122, -0.9852776423889412},
+		{-0.18303988795514095, -0.9831054874312164},
+		{-0.19509032201612825, -0.9807852804032304},
+		{-0.20711137619221856, -0.9783173707196277},
+		{-0.2191012401568698, -0.9757021300385286},
+		{-0.2310581082806711, -0.9729399522055602},
+		{-0.24298017990326387, -0.9700312531945441},
+		{-0.25486565960451457, -0.9669764710448521},
+		{-0.26671275747489837, -0.9637760657954398},
+		{-0.27851968938505306, -0.9604305194155658},
+		{-0.29028467725446233, -0.9569403357322088},
+		{-0.3020059493192281, -0.9533060403541938},
+		{-0.3136817403988915, -0.9495281805930367},
+		{-0.3253102921622629, -0.9456073253805213},
+		{-0.33688985339222005, -0.9415440651830208},
+		{-0.34841868024943456, -0.937339011912575},
+		{-0.3598950365349881, -0.9329927988347388},
+		{-0.37131719395183754, -0.9285060804732156},
+		{-0.3826834323650898, -0.9238795325112867},
+		{-0.3939920400610481, -0.9191138516900577},
+		{-0.40524131400498986, -0.9142097557035307},
+		{-0.41642956009763715, -0.9091679830905225},
+		{-0.4275550934302821, -0.9039892931234433},
+		{-0.43861623853852766, -0.8986744656939538},
+		{-0.44961132965460654, -0.8932243011955153},
+		{-0.46053871095824, -0.8876396204028539},
+		{-0.47139673682599764, -0.881921264348355},
+		{-0.4821837720791227, -0.8760700941954066},
+		{-0.49289819222978404, -0.8700869911087113},
+		{-0.5035383837257176, -0.8639728561215867},
+		{-0.5141027441932217, -0.8577286100002721},
+		{-0.524589682678469, -0.8513551931052652},
+		{-0.5349976198870972, -0.8448535652497071},
+		{-0.5453249884220465, -0.8382247055548381},
+		{-0.5555702330196022, -0.8314696123025453},
+		{-0.5657318107836131, -0.8245893027850253},
+		{-0.5758081914178453, -0.8175848131515837},
+		{-0.5857978574564389, -0.8104571982525948},
+		{-0.5956993044924334, -0.8032075314806449},
+		{-0.6055110414043255, -0.7958369046088836},
+		{-0.6152315905806268, -0.7883464276266063},
+		{-0.6248594881423863, -0.7807372285720945},
+		{-0.6343932841636455, -0.773010453362737},
+		{-0.6438315428897914, -0.765167265622459},
+		{-0.6531728429537768, -0.7572088465064846},
+		{-0.6624157775901718, -0.7491363945234594},
+		{-0.6715589548470183, -0.7409511253549591},
+		{-0.680600997795453, -0.7326542716724129},
+		{-0.6895405447370668, -0.724247082951467},
+		{-0.6983762494089729, -0.7157308252838186},
+		{-0.7071067811865475, -0.7071067811865476},
+		{-0.7157308252838186, -0.6983762494089729},
+		{-0.7242470829514668, -0.6895405447370669},
+		{-0.7326542716724128, -0.6806009977954531},
+		{-0.7409511253549591, -0.6715589548470184},
+		{-0.7491363945234593, -0.6624157775901718},
+		{-0.7572088465064845, -0.6531728429537769},
+		{-0.765167265622459, -0.6438315428897915},
+		{-0.7730104533627369, -0.6343932841636456},
+		{-0.7807372285720944, -0.6248594881423865},
+		{-0.7883464276266062, -0.6152315905806269},
+		{-0.7958369046088835, -0.6055110414043255},
+		{-0.8032075314806448, -0.5956993044924335},
+		{-0.8104571982525947, -0.5857978574564389},
+		{-0.8175848131515836, -0.5758081914178454},
+		{-0.8245893027850253, -0.5657318107836132},
+		{-0.8314696123025452, -0.5555702330196023},
+		{-0.838224705554838, -0.5453249884220466},
+		{-0.844853565249707, -0.5349976198870974},
+		{-0.8513551931052652, -0.524589682678469},
+		{-0.8577286100002721, -0.5141027441932218},
+		{-0.8639728561215866, -0.5035383837257176},
+		{-0.8700869911087113, -0.49289819222978415},
+		{-0.8760700941954065, -0.4821837720791229},
+		{-0.8819212643483549, -0.4713967368259978},
+		{-0.8876396204028539, -0.46053871095824},
+		{-0.8932243011955153, -0.4496113296546066},
+		{-0.8986744656939538, -0.4386162385385277},
+		{-0.9039892931234433, -0.4275550934302822},
+		{-0.9091679830905224, -0.4164295600976373},
+		{-0.9142097557035307, -0.4052413140049898},
+		{-0.9191138516900577, -0.3939920400610481},
+		{-0.9238795325112867, -0.38268343236508984},
+		{-0.9285060804732156, -0.3713171939518376},
+		{-0.9329927988347387, -0.3598950365349883},
+		{-0.937339011912575, -0.3484186802494345},
+		{-0.9415440651830208, -0.33688985339222005},
+		{-0.9456073253805213, -0.325310292162263},
+		{-0.9495281805930367, -0.3136817403988915},
+		{-0.9533060403541938, -0.3020059493192282},
+		{-0.9569403357322089, -0.2902846772544623},
+		{-0.9604305194155658, -0.27851968938505306},
+		{-0.9637760657954398, -0.2667127574748984},
+		{-0.9669764710448521, -0.2548656596045146},
+		{-0.970031253194544, -0.24298017990326398},
+		{-0.9729399522055602, -0.23105810828067125},
+		{-0.9757021300385286, -0.21910124015686977},
+		{-0.9783173707196277, -0.20711137619221856},
+		{-0.9807852804032304, -0.1950903220161283},
+		{-0.9831054874312164, -0.18303988795514103},
+		{-0.9852776423889412, -0.17096188876030136},
+		{-0.9873014181578584, -0.1588581433338614},
+		{-0.989176509964781, -0.14673047445536175},
+		{-0.99090263542778, -0.13458070850712622},
+		{-0.9924795345987101, -0.12241067519921628},
+		{-0.9939069700023561, -0.11022220729388318},
+		{-0.9951847266721968, -0.09801714032956077},
+		{-0.996312612182778, -0.08579731234443988},
+		{-0.9972904566786902, -0.07356456359966745},
+		{-0.9981181129001492, -0.061320736302208655},
+		{-0.9987954562051724, -0.04906767432741813},
+		{-0.9993223845883494, -0.03680722294135899},
+		{-0.9996988186962041, -0.024541228522912267},
+		{-0.9999247018391446, -0.012271538285719944},
+	},
+	10: {
+		{1, 0},
+		{0.9999811752826011, -0.006135884649154475},
+		{0.9999247018391446, -0.012271538285719925},
+		{0.9998305817958234, -0.01840672990580482},
+		{0.9996988186962041, -0.024541228522912288},
+		{0.9995294175010931, -0.030674803176636626},
+		{0.9993223845883494, -0.03680722294135883},
+		{0.9990777277526454, -0.04293825693494082},
+		{0.9987954562051724, -0.049067674327418015},
+		{0.9984755805732948, -0.05519524434968994},
+		{0.9981181129001492, -0.06132073630220858},
+		{0.9977230666441916, -0.06744391956366405},
+		{0.9972904566786902, -0.07356456359966743},
+		{0.9968202992911658, -0.07968243797143013},
+		{0.996312612182778, -0.0857973123444399},
+		{0.9957674144676598, -0.09190895649713272},
+		{0.9951847266721968, -0.0980171403295606},
+		{0.9945645707342554, -0.10412163387205459},
+		{0.9939069700023561, -0.11022220729388306},
+		{0.9932119492347945, -0.11631863091190475},
+		{0.9924795345987101, -0.1224106751992162},
+		{0.9917097536690995, -0.12849811079379317},
+		{0.99090263542778, -0.13458070850712617},
+		{0.9900582102622971, -0.1406582393328492},
+		{0.989176509964781, -0.14673047445536175},
+		{0.9882575677307495, -0.15279718525844344},
+		{0.9873014181578584, -0.15885814333386145},
+		{0.9863080972445987, -0.16491312048996992},
+		{0.9852776423889412, -0.17096188876030122},
+		{0.984210092386929, -0.17700422041214875},
+		{0.9831054874312164, -0.18303988795514095},
+		{0.9819638691095552, -0.1890686641498062},
+		{0.9807852804032304, -0.19509032201612825},
+		{0.9795697656854406, -0.2011046348420919},
+		{0.9783173707196277, -0.20711137619221856},
+		{0.9770281426577543, -0.21311031991609136},
+		{0.9757021300385286, -0.2191012401568698},
+		{0.9743393827855759, -0.22508391135979283},
+		{0.9729399522055602, -0.2310581082806711},
+		{0.9715038909862518, -0.2370236059943672},
+		{0.9700312531945441, -0.24298017990326387},
+		{0.9685220942744173, -0.24892760574572015},
+		{0.9669764710448521, -0.25486565960451457},
+		{0.9653944416976894, -0.2607941179152755},
+		{0.9637760657954398, -0.26671275747489837},
+		{0.9621214042690416, -0.272621355449949},
+		{0.9604305194155658, -0.27851968938505306},
+		{0.9587034748958715, -0.2844075372112719},
+		{0.9569403357322088, -0.29028467725446233},
+		{0.9551411683057708, -0.2961508882436238},
+		{0.9533060403541938, -0.3020059493192281},
+		{0.9514350209690083, -0.30784964004153487},
+		{0.9495281805930367, -0.3136817403988915},
+		{0.9475855910177412, -0.3195020308160157},
+		{0.9456073253805213, -0.3253102921622629},
+		{0.9435934581619604, -0.33110630575987643},
+		{0.9415440651830208, -0.33688985339222005},
+		{0.9394592236021899, -0.3426607173119944},
+		{0.937339011912575, -0.34841868024943456},
+		{0.9351835099389476, -0.35416352542049034},
+		{0.9329927988347388, -0.3598950365349881},
+		{0.9307669610789837, -0.36561299780477385},
+		{0.9285060804732156, -0.37131719395183754},
+		{0.9262102421383114, -0.37700741021641826},
+		{0.9238795325112867, -0.3826834323650898},
+		{0.921514039342042, -0.38834504669882625},
+		{0.9191138516900577, -0.3939920400610481},
+		{0.9166790599210427, -0.3996241998456468},
+		{0.9142097557035307, -0.40524131400498986},
+		{0.9117060320054299, -0.4108431710579039},
+		{0.9091679830905225, -0.41642956009763715},
+		{0.9065957045149153, -0.4220002707997997},
+		{0.9039892931234433, -0.4275550934302821},
+		{0.901348847046022, -0.43309381885315196},
+		{0.8986744656939538, -0.43861623853852766},
+		{0.8959662497561852, -0.4441221445704292},
+		{0.8932243011955153, -0.44961132965460654},
+		{0.8904487232447579, -0.45508358712634384},
+		{0.8876396204028539, -0.46053871095824},
+		{0.8847970984309378, -0.4659764957679662},
+		{0.881921264348355, -0.47139673682599764},
+		{0.8790122264286335, -0.4767992300633221},
+		{0.8760700941954066, -0.4821837720791227},
+		{0.8730949784182901, -0.487550160148436},
+		{0.8700869911087113, -0.49289819222978404},
+		{0.8670462455156926, -0.49822766697278187},
+		{0.8639728561215867, -0.5035383837257176},
+		{0.8608669386377673, -0.508830142543107},
+		{0.8577286100002721, -0.5141027441932217},
+		{0.8545579883654005, -0.5193559901655896},
+		{0.8513551931052652, -0.524589682678469},
+		{0.8481203448032972, -0.5298036246862946},
+		{0.8448535652497071, -0.5349976198870972},
+		{0.8415549774368984, -0.5401714727298929},
+		{0.8382247055548381, -0.5453249884220465},
+		{0.8348628749863801, -0.5504579729366048},
+		{0.8314696123025453, -0.5555702330196022},
+		{0.8280450452577557, -0.560661576197336},
+		{0.8245893027850253, -0.5657318107836131},
+		{0.8211025149911046, -0.5707807458869673},
+		{0.8175848131515837, -0.5758081914178453},
+		{0.8140363297059484, -0.5808139580957645},
+		{0.8104571982525948, -0.5857978574564389},
+		{0.8068475535437993, -0.5907597018588742},
+		{0.8032075314806449, -0.5956993044924334},
+		{0.799537269107905, -0.600616479383869},
+		{0.7958369046088836, -0.6055110414043255},
+		{0.7921065773002124, -0.6103828062763095},
+		{0.7883464276266063, -0.6152315905806268},
+		{0.7845565971555752, -0.6200572117632891},
+		{0.7807372285720945, -0.6248594881423863},
+		{0.7768884656732324, -0.629638238914927},
+		{0.773010453362737, -0.6343932841636455},
+		{0.7691033376455796, -0.6391244448637757},
+		{0.765167265622459, -0.6438315428897914},
+		{0.7612023854842618, -0.6485144010221124},
+		{0.7572088465064846, -0.6531728429537768},
+		{0.7531867990436125, -0.6578066932970786},
+		{0.7491363945234594, -0.6624157775901718},
+		{0.745057785441466, -0.6669999223036375},
+		{0.7409511253549591, -0.6715589548470183},
+		{0.7368165688773699, -0.6760927035753159},
+		{0.7326542716724129, -0.680600997795453},
+		{0.7284643904482252, -0.6850836677727004},
+		{0.724247082951467, -0.6895405447370668},
+		{0.7200025079613818, -0.6939714608896539},
+		{0.7157308252838186, -0.6983762494089729},
+		{0.7114321957452164, -0.7027547444572253},
+		{0.7071067811865476, -0.7071067811865475},
+		{0.7027547444572254, -0.7114321957452164},
+		{0.6983762494089729, -0.7157308252838186},
+		{0.693971460889654, -0.7200025079613817},
+		{0.6895405447370669, -0.7242470829514668},
+		{0.6850836677727005, -0.7284643904482252},
+		{0.6806009977954531, -0.7326542716724128},
+		{0.676092703575316, -0.7368165688773699},
+		{0.6715589548470184, -0.7409511253549591},
+		{0.6669999223036376, -0.745057785441466},
+		{0.6624157775901718, -0.7491363945234593},
+		{0.6578066932970787, -0.7531867990436125},
+		{0.6531728429537769, -0.7572088465064845},
+		{0.6485144010221126, -0.7612023854842617},
+		{0.6438315428897915, -0.765167265622459},
+		{0.6391244448637758, -0.7691033376455796},
+		{0.6343932841636456, -0.7730104533627369},
+		{0.6296382389149271, -0.7768884656732324},
+		{0.6248594881423865, -0.7807372285720944},
+		{0.6200572117632892, -0.7845565971555751},
+		{0.6152315905806269, -0.7883464276266062},
+		{0.6103828062763095, -0.7921065773002123},
+		{0.6055110414043255, -0.7958369046088835},
+		{0.600616479383869, -0.7995372691079049},
+		{0.5956993044924335, -0.8032075314806448},
+		{0.5907597018588743, -0.8068475535437992},
+		{0.5857978574564389, -0.8104571982525947},
+		{0.5808139580957646, -0.8140363297059483},
+		{0.5758081914178454, -0.8175848131515836},
+		{0.5707807458869674, -0.8211025149911046},
+		{0.5657318107836132, -0.8245893027850253},
+		{0.560661576197336, -0.8280450452577558},
+		{0.5555702330196023, -0.8314696123025452},
+		{0.5504579729366049, -0.83486287498638},
+		{0.5453249884220466, -0.838224705554838},
+		{0.540171472729893, -0.8415549774368983},
+		{0.5349976198870974, -0.844853565249707},
+		{0.5298036246862948, -0.8481203448032971},
+		{0.524589682678469, -0.8513551931052652},
+		{0.5193559901655896, -0.8545579883654005},
+		{0.5141027441932218, -0.8577286100002721},
+		{0.5088301425431071, -0.8608669386377672},
+		{0.5035383837257176, -0.8639728561215866},
+		{0.4982276669727819, -0.8670462455156926},
+		{0.49289819222978415, -0.8700869911087113},
+		{0.4875501601484361, -0.87309497841829},
+		{0.4821837720791229, -0.8760700941954065},
+		{0.47679923006332225, -0.8790122264286334},
+		{0.4713967368259978, -0.8819212643483549},
+		{0.4659764957679661, -0.8847970984309379},
+		{0.46053871095824, -0.8876396204028539},
+		{0.45508358712634384, -0.8904487232447579},
+		{0.4496113296546066, -0.8932243011955153},
+		{0.44412214457042926, -0.8959662497561852},
+		{0.4386162385385277, -0.8986744656939538},
+		{0.433093818853152, -0.901348847046022},
+		{0.4275550934302822, -0.9039892931234433},
+		{0.4220002707997998, -0.9065957045149153},
+		{0.4164295600976373, -0.9091679830905224},
+		{0.4108431710579039, -0.91170603200543},
+		{0.4052413140049898, -0.9142097557035307},
+		{0.3996241998456468, -0.9166790599210427},
+		{0.3939920400610481, -0.9191138516900577},
+		{0.3883450466988263, -0.921514039342042},
+		{0.38268343236508984, -0.9238795325112867},
+		{0.3770074102164183, -0.9262102421383113},
+		{0.3713171939518376, -0.9285060804732156},
+		{0.36561299780477396, -0.9307669610789836},
+		{0.3598950365349883, -0.9329927988347387},
+		{0.3541635254204905, -0.9351835099389475},
+		{0.3484186802494345, -0.937339011912575},
+		{0.3426607173119944, -0.9394592236021899},
+		{0.33688985339222005, -0.9415440651830208},
+		{0.33110630575987643, -0.9435934581619604},
+		{0.325310292162263, -0.9456073253805213},
+		{0.31950203081601575, -0.9475855910177412},
+		{0.3136817403988915, -0.9495281805930367},
+		{0.307849640041535, -0.9514350209690083},
+		{0.3020059493192282, -0.9533060403541938},
+		{0.29615088824362396, -0.9551411683057707},
+		{0.2902846772544623, -0.9569403357322089},
+		{0.2844075372112718, -0.9587034748958715},
+		{0.27851968938505306, -0.9604305194155658},
+		{0.272621355449949, -0.9621214042690416},
+		{0.2667127574748984, -0.9637760657954398},
+		{0.26079411791527557, -0.9653944416976894},
+		{0.2548656596045146, -0.9669764710448521},
+		{0.24892760574572026, -0.9685220942744173},
+		{0.24298017990326398, -0.970031253194544},
+		{0.23702360599436734, -0.9715038909862518},
+		{0.23105810828067125, -0.9729399522055602},
+		{0.22508391135979278, -0.9743393827855759},
+		{0.21910124015686977, -0.9757021300385286},
+		{0.21311031991609136, -0.9770281426577543},
+		{0.20711137619221856, -0.9783173707196277},
+		{0.20110463484209193, -0.9795697656854406},
+		{0.1950903220161283, -0.9807852804032304},
+		{0.18906866414980628, -0.9819638691095552},
+		{0.18303988795514103, -0.9831054874312164},
+		{0.17700422041214886, -0.984210092386929},
+		{0.17096188876030136, -0.9852776423889412},
+		{0.16491312048997006, -0.9863080972445987},
+		{0.1588581433338614, -0.9873014181578584},
+		{0.1527971852584434, -0.9882575677307495},
+		{0.14673047445536175, -0.989176509964781},
+		{0.14065823933284924, -0.9900582102622971},
+		{0.13458070850712622, -0.99090263542778},
+		{0.12849811079379322, -0.9917097536690995},
+		{0.12241067519921628, -0.9924795345987101},
+		{0.11631863091190486, -0.9932119492347945},
+		{0.11022220729388318, -0.9939069700023561},
+		{0.10412163387205473, -0.9945645707342554},
+		{0.09801714032956077, -0.9951847266721968},
+		{0.0919089564971327, -0.9957674144676598},
+		{0.08579731234443988, -0.996312612182778},
+		{0.07968243797143013, -0.9968202992911658},
+		{0.07356456359966745, -0.9972904566786902},
+		{0.0674439195636641, -0.9977230666441916},
+		{0.061320736302208655, -0.9981181129001492},
+		{0.05519524434969003, -0.9984755805732948},
+		{0.04906767432741813, -0.9987954562051724},
+		{0.04293825693494096, -0.9990777277526454},
+		{0.03680722294135899, -0.9993223845883494},
+		{0.030674803176636584, -0.9995294175010931},
+		{0.024541228522912267, -0.9996988186962041},
+		{0.01840672990580482, -0.9998305817958234},
+		{0.012271538285719944, -0.9999247018391446},
+		{0.006135884649154516, -0.9999811752826011},
+		{0, -1},
+		{-0.006135884649154475, -0.9999811752826011},
+		{-0.012271538285719925, -0.9999247018391446},
+		{-0.01840672990580482, -0.9998305817958234},
+		{-0.024541228522912288, -0.9996988186962041},
+		{-0.030674803176636626, -0.9995294175010931},
+		{-0.03680722294135883, -0.9993223845883494},
+		{-0.04293825693494082, -0.9990777277526454},
+		{-0.049067674327418015, -0.9987954562051724},
+		{-0.05519524434968994, -0.9984755805732948},
+		{-0.06132073630220858, -0.9981181129001492},
+		{-0.06744391956366405, -0.9977230666441916},
+		{-0.07356456359966743, -0.9972904566786902},
+		{-0.07968243797143013, -0.9968202992911658},
+		{-0.0857973123444399, -0.996312612182778},
+		{-0.09190895649713272, -0.9957674144676598},
+		{-0.0980171403295606, -0.9951847266721968},
+		{-0.10412163387205459, -0.9945645707342554},
+		{-0.11022220729388306, -0.9939069700023561},
+		{-0.11631863091190475, -0.9932119492347945},
+		{-0.1224106751992162, -0.9924795345987101},
+		{-0.12849811079379317, -0.9917097536690995},
+		{-0.13458070850712617, -0.99090263542778},
+		{-0.1406582393328492, -0.9900582102622971},
+		{-0.14673047445536175, -0.989176509964781},
+		{-0.15279718525844344, -0.9882575677307495},
+		{-0.15885814333386145, -0.9873014181578584},
+		{-0.16491312048996992, -0.9863080972445987},
+		{-0.17096188876030122, -0.9852776423889412},
+		{-0.17700422041214875, -0.984210092386929},
+		{-0.18303988795514095, -0.9831054874312164},
+		{-0.1890686641498062, -0.9819638691095552},
+		{-0.19509032201612825, -0.9807852804032304},
+		{-0.2011046348420919, -0.9795697656854406},
+		{-0.20711137619221856, -0.9783173707196277},
+		{-0.21311031991609136, -0.9770281426577543},
+		{-0.2191012401568698, -0.9757021300385286},
+		{-0.22508391135979283, -0.9743393827855759},
+		{-0.2310581082806711, -0.9729399522055602},
+		{-0.2370236059943672, -0.9715038909862518},
+		{-0.24298017990326387, -0.9700312531945441},
+		{-0.24892760574572015, -0.9685220942744173},
+		{-0.25486565960451457, -0.9669764710448521},
+		{-0.2607941179152755, -0.9653944416976894},
+		{-0.26671275747489837, -0.9637760657954398},
+		{-0.272621355449949, -0.9621214042690416},
+		{-0.27851968938505306, -0.9604305194155658},
+		{-0.2844075372112719, -0.9587034748958715},
+		{-0.29028467725446233, -0.9569403357322088},
+		{-0.2961508882436238, -0.9551411683057708},
+		{-0.3020059493192281, -0.9533060403541938},
+		{-0.30784964004153487, -0.9514350209690083},
+		{-0.3136817403988915, -0.9495281805930367},
+		{-0.3195020308160157, -0.9475855910177412},
+		{-0.3253102921622629, -0.9456073253805213},
+		{-0.33110630575987643, -0.9435934581619604},
+		{-0.33688985339222005, -0.9415440651830208},
+		{-0.3426607173119944, -0.9394592236021899},
+		{-0.34841868024943456, -0.937339011912575},
+		{-0.35416352542049034, -0.9351835099389476},
+		{-0.3598950365349881, -0.9329927988347388},
+		{-0.36561299780477385, -0.9307669610789837},
+		{-0.37131719395183754, -0.9285060804732156},
+		{-0.37700741021641826, -0.9262102421383114},
+		{-0.3826834323650898, -0.9238795325112867},
+		{-0.38834504669882625, -0.921514039342042},
+		{-0.3939920400610481, -0.9191138516900577},
+		{-0.3996241998456468, -0.9166790599210427},
+		{-0.40524131400498986, -0.9142097557035307},
+		{-0.4108431710579039, -0.9117060320054299},
+		{-0.41642956009763715, -0.9091679830905225},
+		{-0.4220002707997997, -0.9065957045149153},
+		{-0.4275550934302821, -0.9039892931234433},
+		{-0.43309381885315196, -0.901348847046022},
+		{-0.43861623853852766, -0.8986744656939538},
+		{-0.4441221445704292, -0.8959662497561852},
+		{-0.44961132965460654, -0.8932243011955153},
+		{-0.45508358712634384, -0.8904487232447579},
+		{-0.46053871095824, -0.8876396204028539},
+		{-0.4659764957679662, -0.8847970984309378},
+		{-0.47139673682599764, -0.881921264348355},
+		{-0.4767992300633221, -0.8790122264286335},
+		{-0.4821837720791227, -0.8760700941954066},
+		{-0.487550160148436, -0.8730949784182901},
+		{-0.49289819222978404, -0.8700869911087113},
+		{-0.49822766697278187, -0.8670462455156926},
+		{-0.5035383837257176, -0.8639728561215867},
+		{-0.508830142543107, -0.8608669386377673},
+		{-0.5141027441932217, -0.8577286100002721},
+		{-0.5193559901655896, -0.8545579883654005},
+		{-0.524589682678469, -0.8513551931052652},
+		{-0.5298036246862946, -0.8481203448032972},
+		{-0.5349976198870972, -0.8448535652497071},
+		{-0.5401714727298929, -0.8415549774368984},
+		{-0.5453249884220465, -0.8382247055548381},
+		{-0.5504579729366048, -0.8348628749863801},
+		{-0.5555702330196022, -0.8314696123025453},
+		{-0.560661576197336, -0.8280450452577557},
+		{-0.5657318107836131, -0.8245893027850253},
+		{-0.5707807458869673, -0.8211025149911046},
+		{-0.5758081914178453, -0.8175848131515837},
+		{-0.5808139580957645, -0.8140363297059484},
+		{-0.5857978574564389, -0.8104571982525948},
+		{-0.5907597018588742, -0.8068475535437993},
+		{-0.5956993044924334, -0.8032075314806449},
+		{-0.600616479383869, -0.799537269107905},
+		{-0.6055110414043255, -0.7958369046088836},
+		{-0.6103828062763095, -0.7921065773002124},
+		{-0.6152315905806268, -0.7883464276266063},
+		{-0.6200572117632891, -0.7845565971555752},
+		{-0.6248594881423863, -0.7807372285720945},
+		{-0.629638238914927, -0.7768884656732324},
+		{-0.6343932841636455, -0.773010453362737},
+		{-0.6391244448637757, -0.7691033376455796},
+		{-0.6438315428897914, -0.765167265622459},
+		{-0.6485144010221124, -0.7612023854842618},
+		{-0.6531728429537768, -0.7572088465064846},
+		{-0.6578066932970786, -0.7531867990436125},
+		{-0.6624157775901718, -0.7491363945234594},
+		{-0.6669999223036375, -0.745057785441466},
+		{-0.6715589548470183, -0.7409511253549591},
+		{-0.6760927035753159, -0.7368165688773699},
+		{-0.680600997795453, -0.7326542716724129},
+		{-0.6850836677727004, -0.7284643904482252},
+		{-0.6895405447370668, -0.724247082951467},
+		{-0.6939714608896539, -0.7200025079613818},
+		{-0.6983762494089729, -0.7157308252838186},
+		{-0.7027547444572253, -0.7114321957452164},
+		{-0.7071067811865475, -0.7071067811865476},
+		{-0.7114321957452164, -0.7027547444572254},
+		{-0.7157308252838186, -0.6983762494089729},
+		{-0.7200025079613817, -0.693971460889654},
+		{-0.7242470829514668, -0.6895405447370669},
+		{-0.7284643904482252, -0.6850836677727005},
+		{-0.7326542716724128, -0.6806009977954531},
+		{-0.7368165688773699, -0.676092703575316},
+		{-0.7409511253549591, -0.6715589548470184},
+		{-0.745057785441466, -0.6669999223036376},
+		{-0.7491363945234593, -0.6624157775901718},
+		{-0.7531867990436125, -0.6578066932970787},
+		{-0.7572088465064845, -0.6531728429537769},
+		{-0.7612023854842617, -0.6485144010221126},
+		{-0.765167265622459, -0.6438315428897915},
+		{-0.7691033376455796, -0.6391244448637758},
+		{-0.7730104533627369, -0.6343932841636456},
+		{-0.7768884656732324, -0.6296382389149271},
+		{-0.7807372285720944, -0.6248594881423865},
+		{-0.7845565971555751, -0.6200572117632892},
+		{-0.7883464276266062, -0.6152315905806269},
+		{-0.7921065773002123, -0.6103828062763095},
+		{-0.7958369046088835, -0.6055110414043255},
+		{-0.7995372691079049, -0.600616479383869},
+		{-0.8032075314806448, -0.5956993044924335},
+		{-0.8068475535437992, -0.5907597018588743},
+		{-0.8104571982525947, -0.5857978574564389},
+		{-0.8140363297059483, -0.5808139580957646},
+		{-0.8175848131515836, -0.5758081914178454},
+		{-0.8211025149911046, -0.5707807458869674},
+		{-0.8245893027850253, -0.5657318107836132},
+		{-0.8280450452577558, -0.560661576197336},
+		{-0.8314696123025452, -0.5555702330196023},
+		{-0.83486287498638, -0.5504579729366049},
+		{-0.838224705554838, -0.5453249884220466},
+		{-0.8415549774368983, -0.540171472729893},
+		{-0.844853565249707, -0.5349976198870974},
+		{-0.8481203448032971, -0.5298036246862948},
+		{-0.8513551931052652, -0.524589682678469},
+		{-0.8545579883654005, -0.5193559901655896},
+		{-0.8577286100002721, -0.5141027441932218},
+		{-0.8608669386377672, -0.5088301425431071},
+		{-0.8639728561215866, -0.5035383837257176},
+		{-0.8670462455156926, -0.4982276669727819},
+		{-0.8700869911087113, -0.49289819222978415},
+		{-0.87309497841829, -0.4875501601484361},
+		{-0.8760700941954065, -0.4821837720791229},
+		{-0.8790122264286334, -0.47679923006332225},
+		{-0.8819212643483549, -0.4713967368259978},
+		{-0.8847970984309379, -0.4659764957679661},
+		{-0.8876396204028539, -0.46053871095824},
+		{-0.8904487232447579, -0.45508358712634384},
+		{-0.8932243011955153, -0.4496113296546066},
+		{-0.8959662497561852, -0.44412214457042926},
+		{-0.8986744656939538, -0.4386162385385277},
+		{-0.901348847046022, -0.433093818853152},
+		{-0.9039892931234433, -0.4275550934302822},
+		{-0.9065957045149153, -0.4220002707997998},
+		{-0.9091679830905224, -0.4164295600976373},
+		{-0.91170603200543, -0.4108431710579039},
+		{-0.9142097557035307, -0.4052413140049898},
+		{-0.9166790599210427, -0.3996241998456468},
+		{-0.9191138516900577, -0.3939920400610481},
+		{-0.921514039342042, -0.3883450466988263},
+		{-0.9238795325112867, -0.38268343236508984},
+		{-0.9262102421383113, -0.3770074102164183},
+		{-0.9285060804732156, -0.3713171939518376},
+		{-0.9307669610789836, -0.36561299780477396},
+		{-0.9329927988347387, -0.3598950365349883},
+		{-0.9351835099389475, -0.3541635254204905},
+		{-0.937339011912575, -0.3484186802494345},
+		{-0.9394592236021899, -0.3426607173119944},
+		{-0.9415440651830208, -0.33688985339222005},
+		{-0.9435934581619604, -0.33110630575987643},
+		{-0.9456073253805213, -0.325310292162263},
+		{-0.9475855910177412, -0.31950203081601575},
+		{-0.9495281805930367, -0.3136817403988915},
+		{-0.9514350209690083, -0.307849640041535},
+		{-0.9533060403541938, -0.3020059493192282},
+		{-0.9551411683057707, -0.29615088824362396},
+		{-0.9569403357322089, -0.2902846772544623},
+		{-0.9587034748958715, -0.2844075372112718},
+		{-0.9604305194155658, -0.27851968938505306},
+		{-0.9621214042690416, -0.272621355449949},
+		{-0.9637760657954398, -0.2667127574748984},
+		{-0.9653944416976894, -0.26079411791527557},
+		{-0.9669764710448521, -0.2548656596045146},
+		{-0.9685220942744173, -0.24892760574572026},
+		{-0.970031253194544, -0.24298017990326398},
+		{-0.9715038909862518, -0.23702360599436734},
+		{-0.9729399522055602, -0.23105810828067125},
+		{-0.9743393827855759, -0.22508391135979278},
+		{-0.9757021300385286, -0.21910124015686977},
+		{-0.9770281426577543, -0.21311031991609136},
+		{-0.9783173707196277, -0.20711137619221856},
+		{-0.9795697656854406, -0.20110463484209193},
+		{-0.9807852804032304, -0.1950903220161283},
+		{-0.9819638691095552, -0.18906866414980628},
+		{-0.9831054874312164, -0.18303988795514103},
+		{-0.984210092386929, -0.17700422041214886},
+		{-0.9852776423889412, -0.17096188876030136},
+		{-0.9863080972445987, -0.16491312048997006},
+		{-0.9873014181578584, -0.1588581433338614},
+		{-0.9882575677307495, -0.1527971852584434},
+		{-0.989176509964781, -0.14673047445536175},
+		{-0.9900582102622971, -0.14065823933284924},
+		{-0.99090263542778, -0.13458070850712622},
+		{-0.9917097536690995, -0.12849811079379322},
+		{-0.9924795345987101, -0.12241067519921628},
+		{-0.9932119492347945, -0.11631863091190486},
+		{-0.9939069700023561, -0.11022220729388318},
+		{-0.9945645707342554, -0.10412163387205473},
+		{-0.9951847266721968, -0.09801714032956077},
+		{-0.9957674144676598, -0.0919089564971327},
+		{-0.996312612182778, -0.08579731234443988},
+		{-0.9968202992911658, -0.07968243797143013},
+		{-0.9972904566786902, -0.07356456359966745},
+		{-0.9977230666441916, -0.0674439195636641},
+		{-0.9981181129001492, -0.061320736302208655},
+		{-0.9984755805732948, -0.05519524434969003},
+		{-0.9987954562051724, -0.04906767432741813},
+		{-0.9990777277526454, -0.04293825693494096},
+		{-0.9993223845883494, -0.03680722294135899},
+		{-0.9995294175010931, -0.030674803176636584},
+		{-0.9996988186962041, -0.024541228522912267},
+		{-0.9998305817958234, -0.01840672990580482},
+		{-0.9999247018391446, -0.012271538285719944},
+		{-0.9999811752826011, -0.006135884649154516},
+	},
+	11: {
+		{1, 0},
+		{0.9999952938095762, -0.003067956762965976},
+		{0.9999811752826011, -0.006135884649154475},
+		{0.9999576445519639, -0.00920375478205982},
+		{0.9999247018391446, -0.012271538285719925},
+		{0.9998823474542126, -0.0153392062849881},
+		{0.9998305817958234, -0.01840672990580482},
+		{0.9997694053512153, -0.021474080275469508},
+		{0.9996988186962041, -0.024541228522912288},
+		{0.9996188224951786, -0.02760814577896574},
+		{0.9995294175010931, -0.030674803176636626},
+		{0.9994306045554617, -0.03374117185137758},
+		{0.9993223845883494, -0.03680722294135883},
+		{0.9992047586183639, -0.03987292758773981},
+		{0.9990777277526454, -0.04293825693494082},
+		{0.9989412931868569, -0.04600318213091462},
+		{0.9987954562051724, -0.049067674327418015},
+		{0.9986402181802653, -0.052131704680283324},
+		{0.9984755805732948, -0.05519524434968994},
+		{0.9983015449338929, -0.05825826450043575},
+		{0.9981181129001492, -0.06132073630220858},
+		{0.997925286198596, -0.06438263092985747},
+		{0.9977230666441916, -0.06744391956366405},
+		{0.9975114561403035, -0.07050457338961386},
+		{0.9972904566786902, -0.07356456359966743},
+		{0.997060070339483, -0.07662386139203149},
+		{0.9968202992911658, -0.07968243797143013},
+		{0.996571145790555, -0.08274026454937569},
+		{0.996312612182778, -0.0857973123444399},
+		{0.996044700901252, -0.0888535525825246},
+		{0.9957674144676598, -0.09190895649713272},
+		{0.9954807554919269, -0.09496349532963899},
+		{0.9951847266721968, -0.0980171403295606},
+		{0.9948793307948056, -0.10106986275482782},
+		{0.9945645707342554, -0.10412163387205459},
+		{0.994240449453188, -0.10717242495680884},
+		{0.9939069700023561, -0.11022220729388306},
+		{0.9935641355205953, -0.11327095217756435},
+		{0.9932119492347945, -0.11631863091190475},
+		{0.992850414459865, -0.11936521481099135},
+		{0.9924795345987101, -0.1224106751992162},
+		{0.9920993131421918, -0.12545498341154623},
+		{0.9917097536690995, -0.12849811079379317},
+		{0.9913108598461154, -0.13154002870288312},
+		{0.99090263542778, -0.13458070850712617},
+		{0.9904850842564571, -0.13762012158648604},
+		{0.9900582102622971, -0.1406582393328492},
+		{0.9896220174632009, -0.14369503315029447},
+		{0.989176509964781, -0.14673047445536175},
+		{0.9887216919603238, -0.1497645346773215},
+		{0.9882575677307495, -0.15279718525844344},
+		{0.9877841416445722, -0.15582839765426523},
+		{0.9873014181578584, -0.15885814333386145},
+		{0.9868094018141855, -0.16188639378011183},
+		{0.9863080972445987, -0.16491312048996992},
+		{0.9857975091675674, -0.16793829497473117},
+		{0.9852776423889412, -0.17096188876030122},
+		{0.9847485018019042, -0.17398387338746382},
+		{0.984210092386929, -0.17700422041214875},
+		{0.9836624192117303, -0.18002290140569951},
+		{0.9831054874312164, -0.18303988795514095},
+		{0.9825393022874412, -0.18605515166344663},
+		{0.9819638691095552, -0.1890686641498062},
+		{0.9813791933137546, -0.19208039704989244},
+		{0.9807852804032304, -0.19509032201612825},
+		{0.9801821359681173, -0.19809841071795356},
+		{0.9795697656854406, -0.2011046348420919},
+		{0.9789481753190622, -0.20410896609281687},
+		{0.9783173707196277, -0.20711137619221856},
+		{0.9776773578245099, -0.2101118368804696},
+		{0.9770281426577543, -0.21311031991609136},
+		{0.9763697313300211, -0.21610679707621952},
+		{0.9757021300385286, -0.2191012401568698},
+		{0.9750253450669941, -0.2220936209732035},
+		{0.9743393827855759, -0.22508391135979283},
+		{0.9736442496508119, -0.22807208317088573},
+		{0.9729399522055602, -0.2310581082806711},
+		{0.9722264970789363, -0.23404195858354343},
+		{0.9715038909862518, -0.2370236059943672},
+		{0.9707721407289502, -0.2400030224487415},
+		{0.9700312531945441, -0.24298017990326387},
+		{0.9692812353565485, -0.2459550503357946},
+		{0.9685220942744173, -0.24892760574572015},
+		{0.9677538370934755, -0.25189781815421697},
+		{0.9669764710448521, -0.25486565960451457},
+		{0.9661900034454125, -0.257831102162159},
+		{0.9653944416976894, -0.2607941179152755},
+		{0.9645897932898128, -0.26375467897483135},
+		{0.9637760657954398, -0.26671275747489837},
+		{0.9629532668736839, -0.2696683255729151},
+		{0.9621214042690416, -0.272621355449949},
+		{0.9612804858113206, -0.27557181931095814},
+		{0.9604305194155658, -0.27851968938505306},
+		{0.9595715130819845, -0.28146493792575794},
+		{0.9587034748958715, -0.2844075372112719},
+		{0.9578264130275328, -0.2873474595447295},
+		{0.9569403357322088, -0.29028467725446233},
+		{0.9560452513499964, -0.29321916269425863},
+		{0.9551411683057708, -0.2961508882436238},
+		{0.9542280951091057, -0.2990798263080405},
+		{0.9533060403541938, -0.3020059493192281},
+		{0.9523750127197659, -0.3049292297354024},
+		{0.9514350209690083, -0.30784964004153487},
+		{0.9504860739494817, -0.3107671527496115},
+		{0.9495281805930367, -0.3136817403988915},
+		{0.9485613499157303, -0.31659337555616585},
+		{0.9475855910177412, -0.3195020308160157},
+		{0.9466009130832836, -0.32240767880106985},
+		{0.9456073253805213, -0.3253102921622629},
+		{0.9446048372614803, -0.3282098435790925},
+		{0.9435934581619604, -0.33110630575987643},
+		{0.9425731976014469, -0.3339996514420094},
+		{0.9415440651830208, -0.33688985339222005},
+		{0.9405060705932684, -0.33977688440682685},
+		{0.9394592236021899, -0.3426607173119944},
+		{0.9384035340631081, -0.3455413249639891},
+		{0.937339011912575, -0.34841868024943456},
+		{0.9362656671702783, -0.3512927560855671},
+		{0.9351835099389476, -0.35416352542049034},
+		{0.934092550404259, -0.35703096123343},
+		{0.9329927988347388, -0.3598950365349881},
+		{0.9318842655816681, -0.3627557243673972},
+		{0.9307669610789837, -0.36561299780477385},
+		{0.9296408958431812, -0.3684668299533723},
+		{0.9285060804732156, -0.37131719395183754},
+		{0.9273625256504011, -0.37416406297145793},
+		{0.9262102421383114, -0.37700741021641826},
+		{0.9250492407826776, -0.37984720892405116},
+		{0.9238795325112867, -0.3826834323650898},
+		{0.9227011283338785, -0.38551605384391885},
+		{0.921514039342042, -0.38834504669882625},
+		{0.9203182767091106, -0.39117038430225387},
+		{0.9191138516900577, -0.3939920400610481},
+		{0.9179007756213905, -0.3968099874167103},
+		{0.9166790599210427, -0.3996241998456468},
+		{0.9154487160882678, -0.40243465085941843},
+		{0.9142097557035307, -0.40524131400498986},
+		{0.9129621904283982, -0.4080441628649787},
+		{0.9117060320054299, -0.4108431710579039},
+		{0.9104412922580671, -0.41363831223843456},
+		{0.9091679830905225, -0.41642956009763715},
+		{0.9078861164876663, -0.4192168883632239},
+		{0.9065957045149153, -0.4220002707997997},
+		{0.9052967593181187, -0.4247796812091088},
+		{0.9039892931234433, -0.4275550934302821},
+		{0.9026733182372588, -0.4303264813400826},
+		{0.901348847046022, -0.43309381885315196},
+		{0.9000158920161602, -0.4358570799222555},
+		{0.8986744656939538, -0.43861623853852766},
+		{0.8973245807054183, -0.44137126873171667},
+		{0.8959662497561852, -0.4441221445704292},
+		{0.8945994856313827, -0.44686884016237416},
+		{0.8932243011955153, -0.44961132965460654},
+		{0.8918407093923427, -0.4523495872337709},
+		{0.8904487232447579, -0.45508358712634384},
+		{0.8890483558546646, -0.45781330359887723},
+		{0.8876396204028539, -0.46053871095824},
+		{0.8862225301488807, -0.46325978355186015},
+		{0.8847970984309378, -0.4659764957679662},
+		{0.8833633386657316, -0.46868882203582796},
+		{0.881921264348355, -0.47139673682599764},
+		{0.8804708890521608, -0.47410021465054997},
+		{0.8790122264286335, -0.4767992300633221},
+		{0.8775452902072614, -0.479493757660153},
+		{0.8760700941954066, -0.4821837720791227},
+		{0.8745866522781761, -0.48486924800079106},
+		{0.8730949784182901, -0.487550160148436},
+		{0.871595086655951, -0.49022648328829116},
+		{0.8700869911087113, -0.49289819222978404},
+		{0.868570705971341, -0.49556526182577254},
+		{0.8670462455156926, -0.49822766697278187},
+		{0.8655136240905691, -0.5008853826112407},
+		{0.8639728561215867, -0.5035383837257176},
+		{0.8624239561110405, -0.5061866453451552},
+		{0.8608669386377673, -0.508830142543107},
+		{0.8593018183570085, -0.5114688504379703},
+		{0.8577286100002721, -0.5141027441932217},
+		{0.8561473283751945, -0.5167317990176499},
+		{0.8545579883654005, -0.5193559901655896},
+		{0.8529606049303636, -0.5219752929371544},
+		{0.8513551931052652, -0.524589682678469},
+		{0.8497417680008524, -0.5271991347819014},
+		{0.8481203448032972, -0.5298036246862946},
+		{0.8464909387740521, -0.5324031278771979},
+		{0.8448535652497071, -0.5349976198870972},
+		{0.8432082396418454, -0.5375870762956454},
+		{0.8415549774368984, -0.5401714727298929},
+		{0.8398937941959995, -0.5427507848645159},
+		{0.8382247055548381, -0.5453249884220465},
+		{0.8365477272235119, -0.5478940591731002},
+		{0.8348628749863801, -0.5504579729366048},
+		{0.8331701647019132, -0.5530167055800275},
+		{0.8314696123025453, -0.5555702330196022},
+		{0.829761233794523, -0.5581185312205561},
+		{0.8280450452577557, -0.560661576197336},
+		{0.8263210628456634, -0.5631993440138341},
+		{0.8245893027850253, -0.5657318107836131},
+		{0.8228497813758264, -0.5682589526701315},
+		{0.8211025149911046, -0.5707807458869673},
+		{0.819347520076797, -0.5732971666980422},
+		{0.8175848131515837, -0.5758081914178453},
+		{0.8158144108067338, -0.5783137964116556},
+		{0.8140363297059484, -0.5808139580957645},
+		{0.812250586585204, -0.5833086529376983},
+		{0.8104571982525948, -0.5857978574564389},
+		{0.808656181588175, -0.5882815482226452},
+		{0.8068475535437993, -0.5907597018588742},
+		{0.8050313311429637, -0.5932322950397998},
+		{0.8032075314806449, -0.5956993044924334},
+		{0.8013761717231402, -0.5981607069963424},
+		{0.799537269107905, -0.600616479383869},
+		{0.7976908409433912, -0.6030665985403482},
+		{0.7958369046088836, -0.6055110414043255},
+		{0.7939754775543372, -0.6079497849677736},
+		{0.7921065773002124, -0.6103828062763095},
+		{0.79023022143731, -0.6128100824294097},
+		{0.7883464276266063, -0.6152315905806268},
+		{0.7864552135990859, -0.6176473079378039},
+		{0.7845565971555752, -0.6200572117632891},
+		{0.7826505961665757, -0.62246127937415},
+		{0.7807372285720945, -0.6248594881423863},
+		{0.778816512381476, -0.6272518154951441},
+		{0.7768884656732324, -0.629638238914927},
+		{0.7749531065948739, -0.6320187359398091},
+		{0.773010453362737, -0.6343932841636455},
+		{0.7710605242618138, -0.6367618612362842},
+		{0.7691033376455796, -0.6391244448637757},
+		{0.7671389119358205, -0.6414810128085832},
+		{0.765167265622459, -0.6438315428897914},
+		{0.7631884172633813, -0.6461760129833163},
+		{0.7612023854842618, -0.6485144010221124},
+		{0.759209188978388, -0.650846684996381},
+		{0.7572088465064846, -0.6531728429537768},
+		{0.7552013768965364, -0.6554928529996153},
+		{0.7531867990436125, -0.6578066932970786},
+		{0.7511651319096864, -0.6601143420674205},
+		{0.7491363945234594, -0.6624157775901718},
+		{0.7471006059801801, -0.6647109782033448},
+		{0.745057785441466, -0.6669999223036375},
+		{0.7430079521351217, -0.669282588346636},
+		{0.7409511253549591, -0.6715589548470183},
+		{0.7388873244606151, -0.673829000378756},
+		{0.7368165688773699, -0.6760927035753159},
+		{0.7347388780959635, -0.6783500431298615},
+		{0.7326542716724129, -0.680600997795453},
+		{0.7305627692278276, -0.6828455463852481},
+		{0.7284643904482252, -0.6850836677727004},
+		{0.726359155084346, -0.687315340891759},
+		{0.724247082951467, -0.6895405447370668},
+		{0.7221281939292153, -0.6917592583641577},
+		{0.7200025079613818, -0.6939714608896539},
+		{0.7178700450557318, -0.696177131491463},
+		{0.7157308252838186, -0.6983762494089729},
+		{0.7135848687807935, -0.7005687939432483},
+		{0.7114321957452164, -0.7027547444572253},
+		{0.7092728264388657, -0.7049340803759049},
+		{0.7071067811865476, -0.7071067811865475},
+		{0.704934080375905, -0.7092728264388656},
+		{0.7027547444572254, -0.7114321957452164},
+		{0.7005687939432484, -0.7135848687807935},
+		{0.6983762494089729, -0.7157308252838186},
+		{0.6961771314914631, -0.7178700450557317},
+		{0.693971460889654, -0.7200025079613817},
+		{0.6917592583641579, -0.7221281939292153},
+		{0.6895405447370669, -0.7242470829514668},
+		{0.6873153408917592, -0.7263591550843459},
+		{0.6850836677727005, -0.7284643904482252},
+		{0.6828455463852481, -0.7305627692278276},
+		{0.6806009977954531, -0.7326542716724128},
+		{0.6783500431298616, -0.7347388780959634},
+		{0.676092703575316, -0.7368165688773699},
+		{0.6738290003787561, -0.738887324460615},
+		{0.6715589548470184, -0.7409511253549591},
+		{0.6692825883466361, -0.7430079521351217},
+		{0.6669999223036376, -0.745057785441466},
+		{0.6647109782033449, -0.7471006059801801},
+		{0.6624157775901718, -0.7491363945234593},
+		{0.6601143420674206, -0.7511651319096864},
+		{0.6578066932970787, -0.7531867990436125},
+		{0.6554928529996155, -0.7552013768965364},
+		{0.6531728429537769, -0.7572088465064845},
+		{0.650846684996381, -0.7592091889783878},
+		{0.6485144010221126, -0.7612023854842617},
+		{0.6461760129833164, -0.7631884172633812},
+		{0.6438315428897915, -0.765167265622459},
+		{0.6414810128085832, -0.7671389119358204},
+		{0.6391244448637758, -0.7691033376455796},
+		{0.6367618612362843, -0.7710605242618137},
+		{0.6343932841636456, -0.7730104533627369},
+		{0.6320187359398091, -0.7749531065948738},
+		{0.6296382389149271, -0.7768884656732324},
+		{0.6272518154951442, -0.7788165123814759},
+		{0.6248594881423865, -0.7807372285720944},
+		{0.6224612793741501, -0.7826505961665756},
+		{0.6200572117632892, -0.7845565971555751},
+		{0.617647307937804, -0.7864552135990858},
+		{0.6152315905806269, -0.7883464276266062},
+		{0.6128100824294098, -0.79023022143731},
+		{0.6103828062763095, -0.7921065773002123},
+		{0.6079497849677737, -0.7939754775543371},
+		{0.6055110414043255, -0.7958369046088835},
+		{0.6030665985403483, -0.797690840943391},
+		{0.600616479383869, -0.7995372691079049},
+		{0.5981607069963424, -0.8013761717231402},
+		{0.5956993044924335, -0.8032075314806448},
+		{0.5932322950397999, -0.8050313311429637},
+		{0.5907597018588743, -0.8068475535437992},
+		{0.5882815482226453, -0.808656181588175},
+		{0.5857978574564389, -0.8104571982525947},
+		{0.5833086529376984, -0.8122505865852039},
+		{0.5808139580957646, -0.8140363297059483},
+		{0.5783137964116557, -0.8158144108067337},
+		{0.5758081914178454, -0.8175848131515836},
+		{0.5732971666980423, -0.8193475200767969},
+		{0.5707807458869674, -0.8211025149911046},
+		{0.5682589526701316, -0.8228497813758263},
+		{0.5657318107836132, -0.8245893027850253},
+		{0.5631993440138342, -0.8263210628456634},
+		{0.560661576197336, -0.8280450452577558},
+		{0.5581185312205562, -0.829761233794523},
+		{0.5555702330196023, -0.8314696123025452},
+		{0.5530167055800276, -0.8331701647019131},
+		{0.5504579729366049, -0.83486287498638},
+		{0.5478940591731003, -0.8365477272235119},
+		{0.5453249884220466, -0.838224705554838},
+		{0.542750784864516, -0.8398937941959994},
+		{0.540171472729893, -0.8415549774368983},
+		{0.5375870762956455, -0.8432082396418453},
+		{0.5349976198870974, -0.844853565249707},
+		{0.532403127877198, -0.8464909387740521},
+		{0.5298036246862948, -0.8481203448032971},
+		{0.5271991347819014, -0.8497417680008524},
+		{0.524589682678469, -0.8513551931052652},
+		{0.5219752929371544, -0.8529606049303636},
+		{0.5193559901655896, -0.8545579883654005},
+		{0.51673179901765, -0.8561473283751944},
+		{0.5141027441932218, -0.8577286100002721},
+		{0.5114688504379705, -0.8593018183570084},
+		{0.5088301425431071, -0.8608669386377672},
+		{0.5061866453451555, -0.8624239561110405},
+		{0.5035383837257176, -0.8639728561215866},
+		{0.5008853826112409, -0.865513624090569},
+		{0.4982276669727819, -0.8670462455156926},
+		{0.49556526182577254, -0.868570705971341},
+		{0.49289819222978415, -0.8700869911087113},
+		{0.49022648328829116, -0.871595086655951},
+		{0.4875501601484361, -0.87309497841829},
+		{0.4848692480007911, -0.8745866522781761},
+		{0.4821837720791229, -0.8760700941954065},
+		{0.47949375766015306, -0.8775452902072612},
+		{0.47679923006332225, -0.8790122264286334},
+		{0.47410021465055, -0.8804708890521608},
+		{0.4713967368259978, -0.8819212643483549},
+		{0.46868882203582796, -0.8833633386657316},
+		{0.4659764957679661, -0.8847970984309379},
+		{0.46325978355186026, -0.8862225301488806},
+		{0.46053871095824, -0.8876396204028539},
+		{0.4578133035988773, -0.8890483558546645},
+		{0.45508358712634384, -0.8904487232447579},
+		{0.452349587233771, -0.8918407093923426},
+		{0.4496113296546066, -0.8932243011955153},
+		{0.4468688401623743, -0.8945994856313826},
+		{0.44412214457042926, -0.8959662497561852},
+		{0.4413712687317166, -0.8973245807054183},
+		{0.4386162385385277, -0.8986744656939538},
+		{0.4358570799222555, -0.9000158920161602},
+		{0.433093818853152, -0.901348847046022},
+		{0.4303264813400826, -0.9026733182372588},
+		{0.4275550934302822, -0.9039892931234433},
+		{0.4247796812091088, -0.9052967593181187},
+		{0.4220002707997998, -0.9065957045149153},
+		{0.41921688836322396, -0.9078861164876663},
+		{0.4164295600976373, -0.9091679830905224},
+		{0.41363831223843456, -0.9104412922580671},
+		{0.4108431710579039, -0.91170603200543},
+		{0.40804416286497874, -0.9129621904283982},
+		{0.4052413140049898, -0.9142097557035307},
+		{0.40243465085941854, -0.9154487160882678},
+		{0.3996241998456468, -0.9166790599210427},
+		{0.3968099874167104, -0.9179007756213905},
+		{0.3939920400610481, -0.9191138516900577},
+		{0.391170384302254, -0.9203182767091106},
+		{0.3883450466988263, -0.921514039342042},
+		{0.385516053843919, -0.9227011283338785},
+		{0.38268343236508984, -0.9238795325112867},
+		{0.3798472089240511, -0.9250492407826776},
+		{0.3770074102164183, -0.9262102421383113},
+		{0.37416406297145793, -0.9273625256504011},
+		{0.3713171939518376, -0.9285060804732156},
+		{0.3684668299533723, -0.9296408958431812},
+		{0.36561299780477396, -0.9307669610789836},
+		{0.3627557243673972, -0.9318842655816681},
+		{0.3598950365349883, -0.9329927988347387},
+		{0.35703096123343003, -0.9340925504042589},
+		{0.3541635254204905, -0.9351835099389475},
+		{0.35129275608556715, -0.9362656671702783},
+		{0.3484186802494345, -0.937339011912575},
+		{0.34554132496398915, -0.9384035340631081},
+		{0.3426607173119944, -0.9394592236021899},
+		{0.33977688440682696, -0.9405060705932683},
+		{0.33688985339222005, -0.9415440651830208},
+		{0.3339996514420095, -0.9425731976014468},
+		{0.33110630575987643, -0.9435934581619604},
+		{0.32820984357909266, -0.9446048372614801},
+		{0.325310292162263, -0.9456073253805213},
+		{0.32240767880106996, -0.9466009130832835},
+		{0.31950203081601575, -0.9475855910177412},
+		{0.31659337555616585, -0.9485613499157304},
+		{0.3136817403988915, -0.9495281805930367},
+		{0.3107671527496115, -0.9504860739494817},
+		{0.307849640041535, -0.9514350209690083},
+		{0.3049292297354024, -0.9523750127197659},
+		{0.3020059493192282, -0.9533060403541938},
+		{0.2990798263080405, -0.9542280951091057},
+		{0.29615088824362396, -0.9551411683057707},
+		{0.2932191626942587, -0.9560452513499964},
+		{0.2902846772544623, -0.9569403357322089},
+		{0.28734745954472957, -0.9578264130275328},
+		{0.2844075372112718, -0.9587034748958715},
+		{0.28146493792575805, -0.9595715130819845},
+		{0.27851968938505306, -0.9604305194155658},
+		{0.27557181931095825, -0.9612804858113206},
+		{0.272621355449949, -0.9621214042690416},
+		{0.2696683255729152, -0.9629532668736839},
+		{0.2667127574748984, -0.9637760657954398},
+		{0.2637546789748315, -0.9645897932898128},
+		{0.26079411791527557, -0.9653944416976894},
+		{0.25783110216215893, -0.9661900034454125},
+		{0.2548656596045146, -0.9669764710448521},
+		{0.2518978181542169, -0.9677538370934755},
+		{0.24892760574572026, -0.9685220942744173},
+		{0.2459550503357946, -0.9692812353565485},
+		{0.24298017990326398, -0.970031253194544},
+		{0.2400030224487415, -0.9707721407289502},
+		{0.23702360599436734, -0.9715038909862518},
+		{0.23404195858354346, -0.9722264970789363},
+		{0.23105810828067125, -0.9729399522055602},
+		{0.2280720831708858, -0.9736442496508119},
+		{0.22508391135979278, -0.9743393827855759},
+		{0.2220936209732036, -0.9750253450669941},
+		{0.21910124015686977, -0.9757021300385286},
+		{0.2161067970762196, -0.9763697313300211},
+		{0.21311031991609136, -0.9770281426577543},
+		{0.21011183688046972, -0.9776773578245099},
+		{0.20711137619221856, -0.9783173707196277},
+		{0.204108966092817, -0.9789481753190622},
+		{0.20110463484209193, -0.9795697656854406},
+		{0.19809841071795373, -0.9801821359681173},
+		{0.1950903220161283, -0.9807852804032304},
+		{0.19208039704989238, -0.9813791933137546},
+		{0.18906866414980628, -0.9819638691095552},
+		{0.1860551516634466, -0.9825393022874412},
+		{0.18303988795514103, -0.9831054874312164},
+		{0.18002290140569951, -0.9836624192117303},
+		{0.17700422041214886, -0.984210092386929},
+		{0.17398387338746385, -0.9847485018019042},
+		{0.17096188876030136, -0.9852776423889412},
+		{0.1679382949747312, -0.9857975091675674},
+		{0.16491312048997006, -0.9863080972445987},
+		{0.16188639378011188, -0.9868094018141855},
+		{0.1588581433338614, -0.9873014181578584},
+		{0.15582839765426532, -0.9877841416445722},
+		{0.1527971852584434, -0.9882575677307495},
+		{0.14976453467732162, -0.9887216919603238},
+		{0.14673047445536175, -0.989176509964781},
+		{0.14369503315029458, -0.9896220174632008},
+		{0.14065823933284924, -0.9900582102622971},
+		{0.13762012158648618, -0.990485084256457},
+		{0.13458070850712622, -0.99090263542778},
+		{0.13154002870288325, -0.9913108598461153},
+		{0.12849811079379322, -0.9917097536690995},
+		{0.1254549834115462, -0.9920993131421918},
+		{0.12241067519921628, -0.9924795345987101},
+		{0.11936521481099134, -0.992850414459865},
+		{0.11631863091190486, -0.9932119492347945},
+		{0.11327095217756435, -0.9935641355205953},
+		{0.11022220729388318, -0.9939069700023561},
+		{0.10717242495680887, -0.994240449453188},
+		{0.10412163387205473, -0.9945645707342554},
+		{0.10106986275482786, -0.9948793307948056},
+		{0.09801714032956077, -0.9951847266721968},
+		{0.09496349532963906, -0.9954807554919269},
+		{0.0919089564971327, -0.9957674144676598},
+		{0.08885355258252468, -0.996044700901252},
+		{0.08579731234443988, -0.996312612182778},
+		{0.0827402645493758, -0.9965711457905548},
+		{0.07968243797143013, -0.9968202992911658},
+		{0.07662386139203162, -0.997060070339483},
+		{0.07356456359966745, -0.9972904566786902},
+		{0.07050457338961401, -0.9975114561403035},
+		{0.0674439195636641, -0.9977230666441916},
+		{0.06438263092985741, -0.997925286198596},
+		{0.061320736302208655, -0.9981181129001492},
+		{0.05825826450043573, -0.9983015449338929},
+		{0.05519524434969003, -0.9984755805732948},
+		{0.05213170468028332, -0.9986402181802653},
+		{0.04906767432741813, -0.9987954562051724},
+		{0.046003182130914644, -0.9989412931868569},
+		{0.04293825693494096, -0.9990777277526454},
+		{0.039872927587739845, -0.9992047586183639},
+		{0.03680722294135899, -0.9993223845883494},
+		{0.03374117185137764, -0.9994306045554617},
+		{0.030674803176636584, -0.9995294175010931},
+		{0.02760814577896582, -0.9996188224951786},
+		{0.024541228522912267, -0.9996988186962041},
+		{0.02147408027546961, -0.9997694053512153},
+		{0.01840672990580482, -0.9998305817958234},
+		{0.01533920628498822, -0.9998823474542126},
+		{0.012271538285719944, -0.9999247018391446},
+		{0.00920375478205996, -0.9999576445519639},
+		{0.006135884649154516, -0.9999811752826011},
+		{0.003067956762966138, -0.9999952938095762},
+		{0, -1},
+		{-0.003067956762965976, -0.9999952938095762},
+		{-0.006135884649154475, -0.9999811752826011},
+		{-0.00920375478205982, -0.9999576445519639},
+		{-0.012271538285719925, -0.9999247018391446},
+		{-0.0153392062849881, -0.9998823474542126},
+		{-0.01840672990580482, -0.9998305817958234},
+		{-0.021474080275469508, -0.9997694053512153},
+		{-0.024541228522912288, -0.9996988186962041},
+		{-0.02760814577896574, -0.9996188224951786},
+		{-0.030674803176636626, -0.9995294175010931},
+		{-0.03374117185137758, -0.9994306045554617},
+		{-0.03680722294135883, -0.9993223845883494},
+		{-0.03987292758773981, -0.9992047586183639},
+		{-0.04293825693494082, -0.9990777277526454},
+		{-0.04600318213091462, -0.9989412931868569},
+		{-0.049067674327418015, -0.9987954562051724},
+		{-0.052131704680283324, -0.9986402181802653},
+		{-0.05519524434968994, -0.9984755805732948},
+		{-0.05825826450043575, -0.9983015449338929},
+		{-0.06132073630220858, -0.9981181129001492},
+		{-0.06438263092985747, -0.997925286198596},
+		{-0.06744391956366405, -0.9977230666441916},
+		{-0.07050457338961386, -0.9975114561403035},
+		{-0.07356456359966743, -0.9972904566786902},
+		{-0.07662386139203149, -0.997060070339483},
+		{-0.07968243797143013, -0.9968202992911658},
+		{-0.08274026454937569, -0.996571145790555},
+		{-0.0857973123444399, -0.996312612182778},
+		{-0.0888535525825246, -0.996044700901252},
+		{-0.09190895649713272, -0.9957674144676598},
+		{-0.09496349532963899, -0.9954807554919269},
+		{-0.0980171403295606, -0.9951847266721968},
+		{-0.10106986275482782, -0.9948793307948056},
+		{-0.10412163387205459, -0.9945645707342554},
+		{-0.10717242495680884, -0.994240449453188},
+		{-0.11022220729388306, -0.9939069700023561},
+		{-0.11327095217756435, -0.9935641355205953},
+		{-0.11631863091190475, -0.9932119492347945},
+		{-0.11936521481099135, -0.992850414459865},
+		{-0.1224106751992162, -0.9924795345987101},
+		{-0.12545498341154623, -0.9920993131421918},
+		{-0.12849811079379317, -0.9917097536690995},
+		{-0.13154002870288312, -0.9913108598461154},
+		{-0.13458070850712617, -0.99090263542778},
+		{-0.13762012158648604, -0.9904850842564571},
+		{-0.1406582393328492, -0.9900582102622971},
+		{-0.14369503315029447, -0.9896220174632009},
+		{-0.14673047445536175, -0.989176509964781},
+		{-0.1497645346773215, -0.9887216919603238},
+		{-0.15279718525844344, -0.9882575677307495},
+		{-0.15582839765426523, -0.9877841416445722},
+		{-0.15885814333386145, -0.9873014181578584},
+		{-0.16188639378011183, -0.9868094018141855},
+		{-0.16491312048996992, -0.9863080972445987},
+		{-0.16793829497473117, -0.9857975091675674},
+		{-0.17096188876030122, -0.9852776423889412},
+		{-0.17398387338746382, -0.9847485018019042},
+		{-0.17700422041214875, -0.984210092386929},
+		{-0.18002290140569951, -0.9836624192117303},
+		{-0.18303988795514095, -0.9831054874312164},
+		{-0.18605515166344663, -0.9825393022874412},
+		{-0.1890686641498062, -0.9819638691095552},
+		{-0.19208039704989244, -0.9813791933137546},
+		{-0.19509032201612825, -0.9807852804032304},
+		{-0.19809841071795356, -0.9801821359681173},
+		{-0.2011046348420919, -0.9795697656854406},
+		{-0.20410896609281687, -0.9789481753190622},
+		{-0.20711137619221856, -0.9783173707196277},
+		{-0.2101118368804696, -0.9776773578245099},
+		{-0.21311031991609136, -0.9770281426577543},
+		{-0.21610679707621952, -0.9763697313300211},
+		{-0.2191012401568698, -0.9757021300385286},
+		{-0.2220936209732035, -0.9750253450669941},
+		{-0.22508391135979283, -0.9743393827855759},
+		{-0.22807208317088573, -0.9736442496508119},
+		{-0.2310581082806711, -0.9729399522055602},
+		{-0.23404195858354343, -0.9722264970789363},
+		{-0.2370236059943672, -0.9715038909862518},
+		{-0.2400030224487415, -0.9707721407289502},
+		{-0.24298017990326387, -0.9700312531945441},
+		{-0.2459550503357946, -0.9692812353565485},
+		{-0.24892760574572015, -0.9685220942744173},
+		{-0.25189781815421697, -0.9677538370934755},
+		{-0.25486565960451457, -0.9669764710448521},
+		{-0.257831102162159, -0.9661900034454125},
+		{-0.2607941179152755, -0.9653944416976894},
+		{-0.26375467897483135, -0.9645897932898128},
+		{-0.26671275747489837, -0.9637760657954398},
+		{-0.2696683255729151, -0.9629532668736839},
+		{-0.272621355449949, -0.9621214042690416},
+		{-0.27557181931095814, -0.9612804858113206},
+		{-0.27851968938505306, -0.9604305194155658},
+		{-0.28146493792575794, -0.9595715130819845},
+		{-0.2844075372112719, -0.9587034748958715},
+		{-0.2873474595447295, -0.9578264130275328},
+		{-0.29028467725446233, -0.9569403357322088},
+		{-0.29321916269425863, -0.9560452513499964},
+		{-0.2961508882436238, -0.9551411683057708},
+		{-0.2990798263080405, -0.9542280951091057},
+		{-0.3020059493192281, -0.9533060403541938},
+		{-0.3049292297354024, -0.9523750127197659},
+		{-0.30784964004153487, -0.9514350209690083},
+		{-0.3107671527496115, -0.9504860739494817},
+		{-0.3136817403988915, -0.9495281805930367},
+		{-0.31659337555616585, -0.9485613499157303},
+		{-0.3195020308160157, -0.9475855910177412},
+		{-0.32240767880106985, -0.9466009130832836},
+		{-0.3253102921622629, -0.9456073253805213},
+		{-0.3282098435790925, -0.9446048372614803},
+		{-0.33110630575987643, -0.9435934581619604},
+		{-0.3339996514420094, -0.9425731976014469},
+		{-0.33688985339222005, -0.9415440651830208},
+		{-0.33977688440682685, -0.9405060705932684},
+		{-0.3426607173119944, -0.9394592236021899},
+		{-0.3455413249639891, -0.9384035340631081},
+		{-0.34841868024943456, -0.937339011912575},
+		{-0.3512927560855671, -0.9362656671702783},
+		{-0.35416352542049034, -0.9351835099389476},
+		{-0.35703096123343, -0.934092550404259},
+		{-0.3598950365349881, -0.9329927988347388},
+		{-0.3627557243673972, -0.9318842655816681},
+		{-0.36561299780477385, -0.9307669610789837},
+		{-0.3684668299533723, -0.9296408958431812},
+		{-0.37131719395183754, -0.9285060804732156},
+		{-0.37416406297145793, -0.9273625256504011},
+		{-0.37700741021641826, -0.9262102421383114},
+		{-0.37984720892405116, -0.9250492407826776},
+		{-0.3826834323650898, -0.9238795325112867},
+		{-0.38551605384391885, -0.9227011283338785},
+		{-0.38834504669882625, -0.921514039342042},
+		{-0.39117038430225387, -0.9203182767091106},
+		{-0.3939920400610481, -0.9191138516900577},
+		{-0.3968099874167103, -0.9179007756213905},
+		{-0.3996241998456468, -0.9166790599210427},
+		{-0.40243465085941843, -0.9154487160882678},
+		{-0.40524131400498986, -0.9142097557035307},
+		{-0.4080441628649787, -0.9129621904283982},
+		{-0.4108431710579039, -0.9117060320054299},
+		{-0.41363831223843456, -0.9104412922580671},
+		{-0.41642956009763715, -0.9091679830905225},
+		{-0.4192168883632239, -0.9078861164876663},
+		{-0.4220002707997997, -0.9065957045149153},
+		{-0.4247796812091088, -0.9052967593181187},
+		{-0.4275550934302821, -0.9039892931234433},
+		{-0.4303264813400826, -0.9026733182372588},
+		{-0.43309381885315196, -0.901348847046022},
+		{-0.4358570799222555, -0.9000158920161602},
+		{-0.43861623853852766, -0.8986744656939538},
+		{-0.44137126873171667, -0.8973245807054183},
+		{-0.4441221445704292, -0.8959662497561852},
+		{-0.44686884016237416, -0.8945994856313827},
+		{-0.44961132965460654, -0.8932243011955153},
+		{-0.4523495872337709, -0.8918407093923427},
+		{-0.45508358712634384, -0.8904487232447579},
+		{-0.45781330359887723, -0.8890483558546646},
+		{-0.46053871095824, -0.8876396204028539},
+		{-0.46325978355186015, -0.8862225301488807},
+		{-0.4659764957679662, -0.8847970984309378},
+		{-0.46868882203582796, -0.8833633386657316},
+		{-0.47139673682599764, -0.881921264348355},
+		{-0.47410021465054997, -0.8804708890521608},
+		{-0.4767992300633221, -0.8790122264286335},
+		{-0.479493757660153, -0.8775452902072614},
+		{-0.4821837720791227, -0.8760700941954066},
+		{-0.48486924800079106, -0.8745866522781761},
+		{-0.487550160148436, -0.8730949784182901},
+		{-0.49022648328829116, -0.871595086655951},
+		{-0.49289819222978404, -0.8700869911087113},
+		{-0.49556526182577254, -0.868570705971341},
+		{-0.49822766697278187, -0.8670462455156926},
+		{-0.5008853826112407, -0.8655136240905691},
+		{-0.5035383837257176, -0.8639728561215867},
+		{-0.5061866453451552, -0.8624239561110405},
+		{-0.508830142543107, -0.8608669386377673},
+		{-0.5114688504379703, -0.8593018183570085},
+		{-0.5141027441932217, -0.8577286100002721},
+		{-0.5167317990176499, -0.8561473283751945},
+		{-0.5193559901655896, -0.8545579883654005},
+		{-0.5219752929371544, -0.8529606049303636},
+		{-0.524589682678469, -0.8513551931052652},
+		{-0.5271991347819014, -0.8497417680008524},
+		{-0.5298036246862946, -0.8481203448032972},
+		{-0.5324031278771979, -0.8464909387740521},
+		{-0.5349976198870972, -0.8448535652497071},
+		{-0.5375870762956454, -0.8432082396418454},
+		{-0.5401714727298929, -0.8415549774368984},
+		{-0.5427507848645159, -0.8398937941959995},
+		{-0.5453249884220465, -0.8382247055548381},
+		{-0.5478940591731002, -0.8365477272235119},
+		{-0.5504579729366048, -0.8348628749863801},
+		{-0.5530167055800275, -0.8331701647019132},
+		{-0.5555702330196022, -0.8314696123025453},
+		{-0.5581185312205561, -0.829761233794523},
+		{-0.560661576197336, -0.8280450452577557},
+		{-0.5631993440138341, -0.8263210628456634},
+		{-0.5657318107836131, -0.8245893027850253},
+		{-0.5682589526701315, -0.8228497813758264},
+		{-0.5707807458869673, -0.8211025149911046},
+		{-0.5732971666980422, -0.819347520076797},
+		{-0.5758081914178453, -0.8175848131515837},
+		{-0.5783137964116556, -0.8158144108067338},
+		{-0.5808139580957645, -0.8140363297059484},
+		{-0.5833086529376983, -0.812250586585204},
+		{-0.5857978574564389, -0.8104571982525948},
+		{-0.5882815482226452, -0.808656181588175},
+		{-0.5907597018588742, -0.8068475535437993},
+		{-0.5932322950397998, -0.8050313311429637},
+		{-0.5956993044924334, -0.8032075314806449},
+		{-0.5981607069963424, -0.8013761717231402},
+		{-0.600616479383869, -0.799537269107905},
+		{-0.6030665985403482, -0.7976908409433912},
+		{-0.6055110414043255, -0.7958369046088836},
+		{-0.6079497849677736, -0.7939754775543372},
+		{-0.6103828062763095, -0.7921065773002124},
+		{-0.6128100824294097, -0.79023022143731},
+		{-0.6152315905806268, -0.7883464276266063},
+		{-0.6176473079378039, -0.7864552135990859},
+		{-0.6200572117632891, -0.7845565971555752},
+		{-0.62246127937415, -0.7826505961665757},
+		{-0.6248594881423863, -0.7807372285720945},
+		{-0.6272518154951441, -0.778816512381476},
+		{-0.629638238914927, -0.7768884656732324},
+		{-0.6320187359398091, -0.7749531065948739},
+		{-0.6343932841636455, -0.773010453362737},
+		{-0.6367618612362842, -0.7710605242618138},
+		{-0.6391244448637757, -0.7691033376455796},
+		{-0.6414810128085832, -0.7671389119358205},
+		{-0.6438315428897914, -0.765167265622459},
+		{-0.6461760129833163, -0.7631884172633813},
+		{-0.6485144010221124, -0.7612023854842618},
+		{-0.650846684996381, -0.759209188978388},
+		{-0.6531728429537768, -0.7572088465064846},
+		{-0.6554928529996153, -0.7552013768965364},
+		{-0.6578066932970786, -0.7531867990436125},
+		{-0.6601143420674205, -0.7511651319096864},
+		{-0.6624157775901718, -0.7491363945234594},
+		{-0.6647109782033448, -0.7471006059801801},
+		{-0.6669999223036375, -0.745057785441466},
+		{-0.669282588346636, -0.7430079521351217},
+		{-0.6715589548470183, -0.7409511253549591},
+		{-0.673829000378756, -0.7388873244606151},
+		{-0.6760927035753159, -0.7368165688773699},
+		{-0.6783500431298615, -0.7347388780959635},
+		{-0.680600997795453, -0.7326542716724129},
+		{-0.6828455463852481, -0.7305627692278276},
+		{-0.6850836677727004, -0.7284643904482252},
+		{-0.687315340891759, -0.726359155084346},
+		{-0.6895405447370668, -0.724247082951467},
+		{-0.6917592583641577, -0.7221281939292153},
+		{-0.6939714608896539, -0.7200025079613818},
+		{-0.696177131491463, -0.7178700450557318},
+		{-0.6983762494089729, -0.7157308252838186},
+		{-0.7005687939432483, -0.7135848687807935},
+		{-0.7027547444572253, -0.7114321957452164},
+		{-0.7049340803759049, -0.7092728264388657},
+		{-0.7071067811865475, -0.7071067811865476},
+		{-0.7092728264388656, -0.704934080375905},
+		{-0.7114321957452164, -0.7027547444572254},
+		{-0.7135848687807935, -0.7005687939432484},
+		{-0.7157308252838186, -0.6983762494089729},
+		{-0.7178700450557317, -0.6961771314914631},
+		{-0.7200025079613817, -0.693971460889654},
+		{-0.7221281939292153, -0.6917592583641579},
+		{-0.7242470829514668, -0.6895405447370669},
+		{-0.7263591550843459, -0.6873153408917592},
+		{-0.7284643904482252, -0.6850836677727005},
+		{-0.7305627692278276, -0.6828455463852481},
+		{-0.7326542716724128, -0.6806009977954531},
+		{-0.7347388780959634, -0.6783500431298616},
+		{-0.7368165688773699, -0.676092703575316},
+		{-0.738887324460615, -0.6738290003787561},
+		{-0.7409511253549591, -0.6715589548470184},
+		{-0.7430079521351217, -0.6692825883466361},
+		{-0.745057785441466, -0.6669999223036376},
+		{-0.7471006059801801, -0.6647109782033449},
+		{-0.7491363945234593, -0.6624157775901718},
+		{-0.7511651319096864, -0.6601143420674206},
+		{-0.7531867990436125, -0.6578066932970787},
+		{-0.7552013768965364, -0.6554928529996155},
+		{-0.7572088465064845, -0.6531728429537769},
+		{-0.7592091889783878, -0.650846684996381},
+		{-0.7612023854842617, -0.6485144010221126},
+		{-0.7631884172633812, -0.6461760129833164},
+		{-0.765167265622459, -0.6438315428897915},
+		{-0.7671389119358204, -0.6414810128085832},
+		{-0.7691033376455796, -0.6391244448637758},
+		{-0.7710605242618137, -0.6367618612362843},
+		{-0.7730104533627369, -0.6343932841636456},
+		{-0.7749531065948738, -0.6320187359398091},
+		{-0.7768884656732324, -0.6296382389149271},
+		{-0.7788165123814759, -0.6272518154951442},
+		{-0.7807372285720944, -0.6248594881423865},
+		{-0.7826505961665756, -0.6224612793741501},
+		{-0.7845565971555751, -0.6200572117632892},
+		{-0.7864552135990858, -0.617647307937804},
+		{-0.7883464276266062, -0.6152315905806269},
+		{-0.79023022143731, -0.6128100824294098},
+		{-0.7921065773002123, -0.6103828062763095},
+		{-0.7939754775543371, -0.6079497849677737},
+		{-0.7958369046088835, -0.6055110414043255},
+		{-0.797690840943391, -0.6030665985403483},
+		{-0.7995372691079049, -0.600616479383869},
+		{-0.8013761717231402, -0.5981607069963424},
+		{-0.8032075314806448, -0.5956993044924335},
+		{-0.8050313311429637, -0.5932322950397999},
+		{-0.8068475535437992, -0.5907597018588743},
+		{-0.808656181588175, -0.5882815482226453},
+		{-0.8104571982525947, -0.5857978574564389},
+		{-0.8122505865852039, -0.5833086529376984},
+		{-0.8140363297059483, -0.5808139580957646},
+		{-0.8158144108067337, -0.5783137964116557},
+		{-0.8175848131515836, -0.5758081914178454},
+		{-0.8193475200767969, -0.5732971666980423},
+		{-0.8211025149911046, -0.5707807458869674},
+		{-0.8228497813758263, -0.5682589526701316},
+		{-0.8245893027850253, -0.5657318107836132},
+		{-0.8263210628456634, -0.5631993440138342},
+		{-0.8280450452577558, -0.560661576197336},
+		{-0.829761233794523, -0.5581185312205562},
+		{-0.8314696123025452, -0.5555702330196023},
+		{-0.8331701647019131, -0.5530167055800276},
+		{-0.83486287498638, -0.5504579729366049},
+		{-0.8365477272235119, -0.5478940591731003},
+		{-0.838224705554838, -0.5453249884220466},
+		{-0.8398937941959994, -0.542750784864516},
+		{-0.8415549774368983, -0.540171472729893},
+		{-0.8432082396418453, -0.5375870762956455},
+		{-0.844853565249707, -0.5349976198870974},
+		{-0.8464909387740521, -0.532403127877198},
+		{-0.8481203448032971, -0.5298036246862948},
+		{-0.8497417680008524, -0.5271991347819014},
+		{-0.8513551931052652, -0.524589682678469},
+		{-0.8529606049303636, -0.5219752929371544},
+		{-0.8545579883654005, -0.5193559901655896},
+		{-0.8561473283751944, -0.51673179901765},
+		{-0.8577286100002721, -0.5141027441932218},
+		{-0.8593018183570084, -0.5114688504379705},
+		{-0.8608669386377672, -0.5088301425431071},
+		{-0.8624239561110405, -0.5061866453451555},
+		{-0.8639728561215866, -0.5035383837257176},
+		{-0.865513624090569, -0.5008853826112409},
+		{-0.8670462455156926, -0.4982276669727819},
+		{-0.868570705971341, -0.49556526182577254},
+		{-0.8700869911087113, -0.49289819222978415},
+		{-0.871595086655951, -0.49022648328829116},
+		{-0.87309497841829, -0.4875501601484361},
+		{-0.8745866522781761, -0.4848692480007911},
+		{-0.8760700941954065, -0.4821837720791229},
+		{-0.8775452902072612, -0.47949375766015306},
+		{-0.8790122264286334, -0.47679923006332225},
+		{-0.8804708890521608, -0.47410021465055},
+		{-0.8819212643483549, -0.4713967368259978},
+		{-0.8833633386657316, -0.46868882203582796},
+		{-0.8847970984309379, -0.4659764957679661},
+		{-0.8862225301488806, -0.46325978355186026},
+		{-0.8876396204028539, -0.46053871095824},
+		{-0.8890483558546645, -0.4578133035988773},
+		{-0.8904487232447579, -0.45508358712634384},
+		{-0.8918407093923426, -0.452349587233771},
+		{-0.8932243011955153, -0.4496113296546066},
+		{-0.8945994856313826, -0.4468688401623743},
+		{-0.8959662497561852, -0.44412214457042926},
+		{-0.8973245807054183, -0.4413712687317166},
+		{-0.8986744656939538, -0.4386162385385277},
+		{-0.9000158920161602, -0.4358570799222555},
+		{-0.901348847046022, -0.433093818853152},
+		{-0.9026733182372588, -0.4303264813400826},
+		{-0.9039892931234433, -0.4275550934302822},
+		{-0.9052967593181187, -0.4247796812091088},
+		{-0.9065957045149153, -0.4220002707997998},
+		{-0.9078861164876663, -0.41921688836322396},
+		{-0.9091679830905224, -0.4164295600976373},
+		{-0.9104412922580671, -0.41363831223843456},
+		{-0.91170603200543, -0.4108431710579039},
+		{-0.9129621904283982, -0.40804416286497874},
+		{-0.9142097557035307, -0.4052413140049898},
+		{-0.9154487160882678, -0.40243465085941854},
+		{-0.9166790599210427, -0.3996241998456468},
+		{-0.9179007756213905, -0.3968099874167104},
+		{-0.9191138516900577, -0.3939920400610481},
+		{-0.9203182767091106, -0.391170384302254},
+		{-0.921514039342042, -0.3883450466988263},
+		{-0.9227011283338785, -0.385516053843919},
+		{-0.9238795325112867, -0.38268343236508984},
+		{-0.9250492407826776, -0.3798472089240511},
+		{-0.9262102421383113, -0.3770074102164183},
+		{-0.9273625256504011, -0.37416406297145793},
+		{-0.9285060804732156, -0.3713171939518376},
+		{-0.9296408958431812, -0.3684668299533723},
+		{-0.9307669610789836, -0.36561299780477396},
+		{-0.9318842655816681, -0.3627557243673972},
+		{-0.9329927988347387, -0.3598950365349883},
+		{-0.9340925504042589, -0.35703096123343003},
+		{-0.9351835099389475, -0.3541635254204905},
+		{-0.9362656671702783, -0.35129275608556715},
+		{-0.937339011912575, -0.3484186802494345},
+		{-0.9384035340631081, -0.34554132496398915},
+		{-0.9394592236021899, -0.3426607173119944},
+		{-0.9405060705932683, -0.33977688440682696},
+		{-0.9415440651830208, -0.33688985339222005},
+		{-0.9425731976014468, -0.3339996514420095},
+		{-0.9435934581619604, -0.33110630575987643},
+		{-0.9446048372614801, -0.32820984357909266},
+		{-0.9456073253805213, -0.325310292162263},
+		{-0.9466009130832835, -0.32240767880106996},
+		{-0.9475855910177412, -0.31950203081601575},
+		{-0.9485613499157304, -0.31659337555616585},
+		{-0.9495281805930367, -0.3136817403988915},
+		{-0.9504860739494817, -0.3107671527496115},
+		{-0.9514350209690083, -0.307849640041535},
+		{-0.9523750127197659, -0.3049292297354024},
+		{-0.9533060403541938, -0.3020059493192282},
+		{-0.9542280951091057, -0.2990798263080405},
+		{-0.9551411683057707, -0.29615088824362396},
+		{-0.9560452513499964, -0.2932191626942587},
+		{-0.9569403357322089, -0.2902846772544623},
+		{-0.9578264130275328, -0.28734745954472957},
+		{-0.9587034748958715, -0.2844075372112718},
+		{-0.9595715130819845, -0.28146493792575805},
+		{-0.9604305194155658, -0.27851968938505306},
+		{-0.9612804858113206, -0.27557181931095825},
+		{-0.9621214042690416, -0.272621355449949},
+		{-0.9629532668736839, -0.2696683255729152},
+		{-0.9637760657954398, -0.2667127574748984},
+		{-0.9645897932898128, -0.2637546789748315},
+		{-0.9653944416976894, -0.26079411791527557},
+		{-0.9661900034454125, -0.25783110216215893},
+		{-0.9669764710448521, -0.2548656596045146},
+		{-0.9677538370934755, -0.2518978181542169},
+		{-0.9685220942744173, -0.24892760574572026},
+		{-0.9692812353565485, -0.2459550503357946},
+		{-0.970031253194544, -0.24298017990326398},
+		{-0.9707721407289502, -0.2400030224487415},
+		{-0.9715038909862518, -0.23702360599436734},
+		{-0.9722264970789363, -0.23404195858354346},
+		{-0.9729399522055602, -0.23105810828067125},
+		{-0.9736442496508119, -0.2280720831708858},
+		{-0.9743393827855759, -0.22508391135979278},
+		{-0.9750253450669941, -0.2220936209732036},
+		{-0.9757021300385286, -0.21910124015686977},
+		{-0.9763697313300211, -0.2161067970762196},
+		{-0.9770281426577543, -0.21311031991609136},
+		{-0.9776773578245099, -0.21011183688046972},
+		{-0.9783173707196277, -0.20711137619221856},
+		{-0.9789481753190622, -0.204108966092817},
+		{-0.9795697656854406, -0.20110463484209193},
+		{-0.9801821359681173, -0.19809841071795373},
+		{-0.9807852804032304, -0.1950903220161283},
+		{-0.9813791933137546, -0.19208039704989238},
+		{-0.9819638691095552, -0.18906866414980628},
+		{-0.9825393022874412, -0.1860551516634466},
+		{-0.9831054874312164, -0.18303988795514103},
+		{-0.9836624192117303, -0.18002290140569951},
+		{-0.984210092386929, -0.17700422041214886},
+		{-0.9847485018019042, -0.17398387338746385},
+		{-0.9852776423889412, -0.17096188876030136},
+		{-0.9857975091675674, -0.1679382949747312},
+		{-0.9863080972445987, -0.16491312048997006},
+		{-0.9868094018141855, -0.16188639378011188},
+		{-0.9873014181578584, -0.1588581433338614},
+		{-0.9877841416445722, -0.15582839765426532},
+		{-0.9882575677307495, -0.1527971852584434},
+		{-0.9887216919603238, -0.14976453467732162},
+		{-0.989176509964781, -0.14673047445536175},
+		{-0.9896220174632008, -0.14369503315029458},
+		{-0.9900582102622971, -0.14065823933284924},
+		{-0.990485084256457, -0.13762012158648618},
+		{-0.99090263542778, -0.13458070850712622},
+		{-0.9913108598461153, -0.13154002870288325},
+		{-0.9917097536690995, -0.12849811079379322},
+		{-0.9920993131421918, -0.1254549834115462},
+		{-0.9924795345987101, -0.12241067519921628},
+		{-0.992850414459865, -0.11936521481099134},
+		{-0.9932119492347945, -0.11631863091190486},
+		{-0.9935641355205953, -0.11327095217756435},
+		{-0.9939069700023561, -0.11022220729388318},
+		{-0.994240449453188, -0.10717242495680887},
+		{-0.9945645707342554, -0.10412163387205473},
+		{-0.9948793307948056, -0.10106986275482786},
+		{-0.9951847266721968, -0.09801714032956077},
+		{-0.9954807554919269, -0.09496349532963906},
+		{-0.9957674144676598, -0.0919089564971327},
+		{-0.996044700901252, -0.08885355258252468},
+		{-0.996312612182778, -0.08579731234443988},
+		{-0.9965711457905548, -0.0827402645493758},
+		{-0.9968202992911658, -0.07968243797143013},
+		{-0.997060070339483, -0.07662386139203162},
+		{-0.9972904566786902, -0.07356456359966745},
+		{-0.9975114561403035, -0.07050457338961401},
+		{-0.9977230666441916, -0.0674439195636641},
+		{-0.997925286198596, -0.06438263092985741},
+		{-0.9981181129001492, -0.061320736302208655},
+		{-0.9983015449338929, -0.05825826450043573},
+		{-0.9984755805732948, -0.05519524434969003},
+		{-0.9986402181802653, -0.05213170468028332},
+		{-0.9987954562051724, -0.04906767432741813},
+		{-0.9989412931868569, -0.046003182130914644},
+		{-0.9990777277526454, -0.04293825693494096},
+		{-0.9992047586183639, -0.039872927587739845},
+		{-0.9993223845883494, -0.03680722294135899},
+		{-0.9994306045554617, -0.03374117185137764},
+		{-0.9995294175010931, -0.030674803176636584},
+		{-0.9996188224951786, -0.02760814577896582},
+		{-0.9996988186962041, -0.024541228522912267},
+		{-0.9997694053512153, -0.02147408027546961},
+		{-0.9998305817958234, -0.01840672990580482},
+		{-0.9998823474542126, -0.01533920628498822},
+		{-0.9999247018391446, -0.012271538285719944},
+		{-0.9999576445519639, -0.00920375478205996},
+		{-0.9999811752826011, -0.006135884649154516},
+		{-0.9999952938095762, -0.003067956762966138},
+	},
+	12: {
+		{1, 0},
+		{0.9999988234517019, -0.0015339801862847655},
+		{0.9999952938095762, -0.003067956762965976},
+		{0.9999894110819284, -0.0046019261204485705},
+		{0.9999811752826011, -0.006135884649154475},
+		{0.999970586430974, -0.007669828739531097},
+		{0.9999576445519639, -0.00920375478205982},
+		{0.9999423496760239, -0.01073765916726449},
+		{0.9999247018391446, -0.012271538285719925},
+		{0.9999047010828528, -0.01380538852806039},
+		{0.9998823474542126, -0.0153392062849881},
+		{0.9998576410058239, -0.01687298794728171},
+		{0.9998305817958234, -0.01840672990580482},
+		{0.9998011698878841, -0.01994042855151444},
+		{0.9997694053512153, -0.021474080275469508},
+		{0.9997352882605618, -0.02300768146883937},
+		{0.9996988186962041, -0.024541228522912288},
+		{0.9996599967439592, -0.0260747178291039},
+		{0.9996188224951786, -0.02760814577896574},
+		{0.9995752960467492, -0.029141508764193722},
+		{0.9995294175010931, -0.030674803176636626},
+		{0.999481186966167, -0.032208025408304586},
+		{0.9994306045554617, -0.03374117185137758},
+		{0.9993776703880028, -0.03527423889821395},
+		{0.9993223845883494, -0.03680722294135883},
+		{0.9992647472865944, -0.038340120373552694},
+		{0.9992047586183639, -0.03987292758773981},
+		{0.9991424187248169, -0.04140564097707674},
+		{0.9990777277526454, -0.04293825693494082},
+		{0.9990106858540734, -0.04447077185493867},
+		{0.9989412931868569, -0.04600318213091462},
+		{0.9988695499142836, -0.0475354841569593},
+		{0.9987954562051724, -0.049067674327418015},
+		{0.9987190122338729, -0.05059974903689928},
+		{0.9986402181802653, -0.052131704680283324},
+		{0.9985590742297593, -0.05366353765273052},
+		{0.9984755805732948, -0.05519524434968994},
+		{0.9983897374073403, -0.05672682116690775},
+		{0.9983015449338929, -0.05825826450043575},
+		{0.9982110033604781, -0.05978957074663987},
+		{0.9981181129001492, -0.06132073630220858},
+		{0.9980228737714862, -0.0628517575641614},
+		{0.997925286198596, -0.06438263092985747},
+		{0.9978253504111116, -0.0659133527970038},
+		{0.9977230666441916, -0.06744391956366405},
+		{0.9976184351385196, -0.06897432762826675},
+		{0.9975114561403035, -0.07050457338961386},
+		{0.9974021299012753, -0.07203465324688933},
+		{0.9972904566786902, -0.07356456359966743},
+		{0.9971764367353261, -0.0750943008479213},
+		{0.997060070339483, -0.07662386139203149},
+		{0.9969413577649822, -0.07815324163279423},
+		{0.9968202992911658, -0.07968243797143013},
+		{0.996696895202896, -0.08121144680959244},
+		{0.996571145790555, -0.08274026454937569},
+		{0.9964430513500426, -0.08426888759332407},
+		{0.996312612182778, -0.0857973123444399},
+		{0.9961798285956969, -0.08732553520619206},
+		{0.996044700901252, -0.0888535525825246},
+		{0.9959072294174117, -0.09038136087786498},
+		{0.9957674144676598, -0.09190895649713272},
+		{0.9956252563809943, -0.09343633584574779},
+		{0.9954807554919269, -0.09496349532963899},
+		{0.9953339121404823, -0.09649043135525259},
+		{0.9951847266721968, -0.0980171403295606},
+		{0.9950331994381186, -0.09954361866006932},
+		{0.9948793307948056, -0.10106986275482782},
+		{0.9947231211043257, -0.10259586902243628},
+		{0.9945645707342554, -0.10412163387205459},
+		{0.9944036800576791, -0.10564715371341062},
+		{0.994240449453188, -0.10717242495680884},
+		{0.9940748793048794, -0.10869744401313872},
+		{0.9939069700023561, -0.11022220729388306},
+		{0.9937367219407246, -0.11174671121112659},
+		{0.9935641355205953, -0.11327095217756435},
+		{0.9933892111480807, -0.11479492660651008},
+		{0.9932119492347945, -0.11631863091190475},
+		{0.9930323501978514, -0.11784206150832498},
+		{0.992850414459865, -0.11936521481099135},
+		{0.992666142448948, -0.12088808723577708},
+		{0.9924795345987101, -0.1224106751992162},
+		{0.9922905913482573, -0.12393297511851216},
+		{0.9920993131421918, -0.12545498341154623},
+		{0.9919057004306093, -0.12697669649688587},
+		{0.9917097536690995, -0.12849811079379317},
+		{0.991511473318744, -0.13001922272223335},
+		{0.9913108598461154, -0.13154002870288312},
+		{0.9911079137232768, -0.13306052515713906},
+		{0.99090263542778, -0.13458070850712617},
+		{0.9906950254426646, -0.1361005751757062},
+		{0.9904850842564571, -0.13762012158648604},
+		{0.9902728123631691, -0.1391393441638262},
+		{0.9900582102622971, -0.1406582393328492},
+		{0.9898412784588205, -0.14217680351944803},
+		{0.9896220174632009, -0.14369503315029447},
+		{0.9894004277913803, -0.14521292465284746},
+		{0.989176509964781, -0.14673047445536175},
+		{0.988950264510303, -0.14824767898689603},
+		{0.9887216919603238, -0.1497645346773215},
+		{0.9884907928526967, -0.15128103795733022},
+		{0.9882575677307495, -0.15279718525844344},
+		{0.9880220171432835, -0.1543129730130201},
+		{0.9877841416445722, -0.15582839765426523},
+		{0.9875439417943592, -0.15734345561623825},
+		{0.9873014181578584, -0.15885814333386145},
+		{0.987056571305751, -0.16037245724292828},
+		{0.9868094018141855, -0.16188639378011183},
+		{0.9865599102647755, -0.16339994938297323},
+		{0.9863080972445987, -0.16491312048996992},
+		{0.9860539633461954, -0.1664259035404641},
+		{0.9857975091675674, -0.16793829497473117},
+		{0.9855387353121761, -0.16945029123396796},
+		{0.9852776423889412, -0.17096188876030122},
+		{0.9850142310122398, -0.17247308399679595},
+		{0.9847485018019042, -0.17398387338746382},
+		{0.9844804553832209, -0.17549425337727143},
+		{0.984210092386929, -0.17700422041214875},
+		{0.9839374134492189, -0.1785137709389975},
+		{0.9836624192117303, -0.18002290140569951},
+		{0.9833851103215512, -0.18153160826112497},
+		{0.9831054874312164, -0.18303988795514095},
+		{0.9828235511987053, -0.18454773693861962},
+		{0.9825393022874412, -0.18605515166344663},
+		{0.9822527413662894, -0.1875621285825296},
+		{0.9819638691095552, -0.1890686641498062},
+		{0.9816726861969831, -0.19057475482025274},
+		{0.9813791933137546, -0.19208039704989244},
+		{0.9810833911504867, -0.1935855872958036},
+		{0.9807852804032304, -0.19509032201612825},
+		{0.9804848617734694, -0.19659459767008022},
+		{0.9801821359681173, -0.19809841071795356},
+		{0.9798771036995176, -0.19960175762113097},
+		{0.9795697656854406, -0.2011046348420919},
+		{0.9792601226490821, -0.20260703884442113},
+		{0.9789481753190622, -0.20410896609281687},
+		{0.9786339244294232, -0.20561041305309924},
+		{0.9783173707196277, -0.20711137619221856},
+		{0.9779985149345571, -0.20861185197826349},
+		{0.9776773578245099, -0.2101118368804696},
+		{0.9773539001452, -0.21161132736922755},
+		{0.9770281426577543, -0.21311031991609136},
+		{0.9767000861287118, -0.21460881099378676},
+		{0.9763697313300211, -0.21610679707621952},
+		{0.9760370790390391, -0.21760427463848364},
+		{0.9757021300385286, -0.2191012401568698},
+		{0.975364885116657, -0.2205976901088735},
+		{0.9750253450669941, -0.2220936209732035},
+		{0.9746835106885107, -0.22358902922979},
+		{0.9743393827855759, -0.22508391135979283},
+		{0.973992962167956, -0.22657826384561},
+		{0.9736442496508119, -0.22807208317088573},
+		{0.9732932460546981, -0.22956536582051887},
+		{0.9729399522055602, -0.2310581082806711},
+		{0.9725843689347323, -0.23255030703877524},
+		{0.9722264970789363, -0.23404195858354343},
+		{0.9718663374802794, -0.2355330594049755},
+		{0.9715038909862518, -0.2370236059943672},
+		{0.9711391584497251, -0.23851359484431842},
+		{0.9707721407289502, -0.2400030224487415},
+		{0.9704028386875555, -0.24149188530286933},
+		{0.9700312531945441, -0.24298017990326387},
+		{0.9696573851242924, -0.24446790274782415},
+		{0.9692812353565485, -0.2459550503357946},
+		{0.9689028047764289, -0.24744161916777327},
+		{0.9685220942744173, -0.24892760574572015},
+		{0.9681391047463624, -0.2504130065729652},
+		{0.9677538370934755, -0.25189781815421697},
+		{0.9673662922223285, -0.25338203699557016},
+		{0.9669764710448521, -0.25486565960451457},
+		{0.9665843744783331, -0.2563486824899429},
+		{0.9661900034454125, -0.257831102162159},
+		{0.9657933588740837, -0.25931291513288623},
+		{0.9653944416976894, -0.2607941179152755},
+		{0.9649932528549203, -0.2622747070239136},
+		{0.9645897932898128, -0.26375467897483135},
+		{0.9641840639517458, -0.2652340302855118},
+		{0.9637760657954398, -0.26671275747489837},
+		{0.963365799780954, -0.2681908570634032},
+		{0.9629532668736839, -0.2696683255729151},
+		{0.9625384680443592, -0.271145159526808},
+		{0.9621214042690416, -0.272621355449949},
+		{0.9617020765291225, -0.2740969098687064},
+		{0.9612804858113206, -0.27557181931095814},
+		{0.9608566331076797, -0.2770460803060999},
+		{0.9604305194155658, -0.27851968938505306},
+		{0.960002145737666, -0.2799926430802732},
+		{0.9595715130819845, -0.28146493792575794},
+		{0.9591386224618419, -0.2829365704570554},
+		{0.9587034748958715, -0.2844075372112719},
+		{0.9582660714080177, -0.2858778347270806},
+		{0.9578264130275328, -0.2873474595447295},
+		{0.957384500788976, -0.2888164082060495},
+		{0.9569403357322088, -0.29028467725446233},
+		{0.9564939189023951, -0.29175226323498926},
+		{0.9560452513499964, -0.29321916269425863},
+		{0.9555943341307711, -0.2946853721805143},
+		{0.9551411683057708, -0.2961508882436238},
+		{0.9546857549413383, -0.2976157074350862},
+		{0.9542280951091057, -0.2990798263080405},
+		{0.9537681898859903, -0.30054324141727345},
+		{0.9533060403541938, -0.3020059493192281},
+		{0.9528416476011987, -0.3034679465720113},
+		{0.9523750127197659, -0.3049292297354024},
+		{0.9519061368079322, -0.3063897953708609},
+		{0.9514350209690083, -0.30784964004153487},
+		{0.9509616663115752, -0.3093087603122687},
+		{0.9504860739494817, -0.3107671527496115},
+		{0.9500082450018431, -0.3122248139218249},
+		{0.9495281805930367, -0.3136817403988915},
+		{0.9490458818527006, -0.31513792875252244},
+		{0.9485613499157303, -0.31659337555616585},
+		{0.9480745859222762, -0.31804807738501495},
+		{0.9475855910177412, -0.3195020308160157},
+		{0.9470943663527772, -0.3209552324278752},
+		{0.9466009130832836, -0.32240767880106985},
+		{0.9461052323704033, -0.32385936651785285},
+		{0.9456073253805213, -0.3253102921622629},
+		{0.9451071932852606, -0.32676045232013173},
+		{0.9446048372614803, -0.3282098435790925},
+		{0.9441002584912727, -0.3296584625285875},
+		{0.9435934581619604, -0.33110630575987643},
+		{0.9430844374660935, -0.3325533698660442},
+		{0.9425731976014469, -0.3339996514420094},
+		{0.9420597397710174, -0.3354451470845316},
+		{0.9415440651830208, -0.33688985339222005},
+		{0.9410261750508893, -0.3383337669655411},
+		{0.9405060705932684, -0.33977688440682685},
+		{0.9399837530340139, -0.34121920232028236},
+		{0.9394592236021899, -0.3426607173119944},
+		{0.9389324835320645, -0.3441014259899388},
+		{0.9384035340631081, -0.3455413249639891},
+		{0.9378723764399899, -0.3469804108459237},
+		{0.937339011912575, -0.34841868024943456},
+		{0.9368034417359216, -0.3498561297901349},
+		{0.9362656671702783, -0.3512927560855671},
+		{0.9357256894810804, -0.3527285557552107},
+		{0.9351835099389476, -0.35416352542049034},
+		{0.9346391298196808, -0.35559766170478385},
+		{0.934092550404259, -0.35703096123343},
+		{0.9335437729788363, -0.35846342063373654},
+		{0.9329927988347388, -0.3598950365349881},
+		{0.9324396292684624, -0.3613258055684543},
+		{0.9318842655816681, -0.3627557243673972},
+		{0.9313267090811804, -0.3641847895670799},
+		{0.9307669610789837, -0.36561299780477385},
+		{0.930205022892219, -0.3670403457197672},
+		{0.9296408958431812, -0.3684668299533723},
+		{0.9290745812593159, -0.3698924471489341},
+		{0.9285060804732156, -0.37131719395183754},
+		{0.9279353948226179, -0.37274106700951576},
+		{0.9273625256504011, -0.37416406297145793},
+		{0.9267874743045819, -0.3755861784892172},
+		{0.9262102421383114, -0.37700741021641826},
+		{0.9256308305098727, -0.37842775480876556},
+		{0.9250492407826776, -0.37984720892405116},
+		{0.9244654743252626, -0.3812657692221624},
+		{0.9238795325112867, -0.3826834323650898},
+		{0.9232914167195276, -0.38410019501693504},
+		{0.9227011283338785, -0.38551605384391885},
+		{0.9221086687433452, -0.3869310055143886},
+		{0.921514039342042, -0.38834504669882625},
+		{0.9209172415291894, -0.3897581740698564},
+		{0.9203182767091106, -0.39117038430225387},
+		{0.9197171462912272, -0.39258167407295147},
+		{0.9191138516900577, -0.3939920400610481},
+		{0.9185083943252121, -0.39540147894781635},
+		{0.9179007756213905, -0.3968099874167103},
+		{0.9172909970083779, -0.39821756215337356},
+		{0.9166790599210427, -0.3996241998456468},
+		{0.9160649657993317, -0.4010298971835756},
+		{0.9154487160882678, -0.40243465085941843},
+		{0.9148303122379462, -0.4038384575676541},
+		{0.9142097557035307, -0.40524131400498986},
+		{0.9135870479452509, -0.40664321687036903},
+		{0.9129621904283982, -0.4080441628649787},
+		{0.9123351846233227, -0.4094441486922576},
+		{0.9117060320054299, -0.4108431710579039},
+		{0.9110747340551764, -0.4122412266698829},
+		{0.9104412922580671, -0.41363831223843456},
+		{0.9098057081046523, -0.41503442447608163},
+		{0.9091679830905225, -0.41642956009763715},
+		{0.9085281187163062, -0.41782371582021227},
+		{0.9078861164876663, -0.4192168883632239},
+		{0.9072419779152958, -0.4206090744484025},
+		{0.9065957045149153, -0.4220002707997997},
+		{0.9059472978072685, -0.42339047414379605},
+		{0.9052967593181187, -0.4247796812091088},
+		{0.9046440905782461, -0.4261678887267996},
+		{0.9039892931234433, -0.4275550934302821},
+		{0.9033323684945118, -0.4289412920553295},
+		{0.9026733182372588, -0.4303264813400826},
+		{0.9020121439024933, -0.43171065802505726},
+		{0.901348847046022, -0.43309381885315196},
+		{0.900683429228647, -0.43447596056965565},
+		{0.9000158920161602, -0.4358570799222555},
+		{0.8993462369793415, -0.4372371736610441},
+		{0.8986744656939538, -0.43861623853852766},
+		{0.8980005797407399, -0.43999427130963326},
+		{0.8973245807054183, -0.44137126873171667},
+		{0.8966464701786802, -0.44274722756457},
+		{0.8959662497561852, -0.4441221445704292},
+		{0.8952839210385576, -0.44549601651398174},
+		{0.8945994856313827, -0.44686884016237416},
+		{0.8939129451452033, -0.4482406122852199},
+		{0.8932243011955153, -0.44961132965460654},
+		{0.8925335554027647, -0.45098098904510386},
+		{0.8918407093923427, -0.4523495872337709},
+		{0.8911457647945833, -0.45371712100016387},
+		{0.8904487232447579, -0.45508358712634384},
+		{0.8897495863830728, -0.4564489823968839},
+		{0.8890483558546646, -0.45781330359887723},
+		{0.8883450333095964, -0.4591765475219441},
+		{0.8876396204028539, -0.46053871095824},
+		{0.8869321187943422, -0.46189979070246273},
+		{0.8862225301488807, -0.46325978355186015},
+		{0.8855108561362, -0.4646186863062378},
+		{0.8847970984309378, -0.4659764957679662},
+		{0.884081258712635, -0.46733320874198847},
+		{0.8833633386657316, -0.46868882203582796},
+		{0.8826433399795628, -0.4700433324595956},
+		{0.881921264348355, -0.47139673682599764},
+		{0.8811971134712221, -0.4727490319503428},
+		{0.8804708890521608, -0.47410021465054997},
+		{0.8797425928000474, -0.47545028174715587},
+		{0.8790122264286335, -0.4767992300633221},
+		{0.8782797916565416, -0.478147056424843},
+		{0.8775452902072614, -0.479493757660153},
+		{0.8768087238091457, -0.48083933060033396},
+		{0.8760700941954066, -0.4821837720791227},
+		{0.8753294031041109, -0.48352707893291874},
+		{0.8745866522781761, -0.48486924800079106},
+		{0.8738418434653668, -0.4862102761244864},
+		{0.8730949784182901, -0.487550160148436},
+		{0.8723460588943914, -0.48888889691976317},
+		{0.871595086655951, -0.49022648328829116},
+		{0.870842063470079, -0.4915629161065499},
+		{0.8700869911087113, -0.49289819222978404},
+		{0.8693298713486068, -0.4942323085159597},
+		{0.868570705971341, -0.49556526182577254},
+		{0.8678094967633033, -0.49689704902265447},
+		{0.8670462455156926, -0.49822766697278187},
+		{0.8662809540245131, -0.49955711254508184},
+		{0.8655136240905691, -0.5008853826112407},
+		{0.8647442575194623, -0.5022124740457108},
+		{0.8639728561215867, -0.5035383837257176},
+		{0.8631994217121242, -0.5048631085312676},
+		{0.8624239561110405, -0.5061866453451552},
+		{0.8616464611430813, -0.5075089910529709},
+		{0.8608669386377673, -0.508830142543107},
+		{0.8600853904293901, -0.5101500967067668},
+		{0.8593018183570085, -0.5114688504379703},
+		{0.8585162242644427, -0.512786400633563},
+		{0.8577286100002721, -0.5141027441932217},
+		{0.8569389774178287, -0.5154178780194629},
+		{0.8561473283751945, -0.5167317990176499},
+		{0.855353664735196, -0.5180445040959993},
+		{0.8545579883654005, -0.5193559901655896},
+		{0.8537603011381113, -0.5206662541403672},
+		{0.8529606049303636, -0.5219752929371544},
+		{0.8521589016239198, -0.5232831034756564},
+		{0.8513551931052652, -0.524589682678469},
+		{0.8505494812656034, -0.5258950274710846},
+		{0.8497417680008524, -0.5271991347819014},
+		{0.8489320552116396, -0.5285020015422285},
+		{0.8481203448032972, -0.5298036246862946},
+		{0.8473066386858583, -0.531104001151255},
+		{0.8464909387740521, -0.5324031278771979},
+		{0.8456732469872991, -0.533701001807153},
+		{0.8448535652497071, -0.5349976198870972},
+		{0.8440318954900664, -0.5362929790659632},
+		{0.8432082396418454, -0.5375870762956454},
+		{0.8423825996431858, -0.5388799085310084},
+		{0.8415549774368984, -0.5401714727298929},
+		{0.8407253749704581, -0.5414617658531234},
+		{0.8398937941959995, -0.5427507848645159},
+		{0.8390602370703127, -0.5440385267308838},
+		{0.8382247055548381, -0.5453249884220465},
+		{0.8373872016156619, -0.5466101669108349},
+		{0.8365477272235119, -0.5478940591731002},
+		{0.8357062843537526, -0.5491766621877197},
+		{0.8348628749863801, -0.5504579729366048},
+		{0.8340175011060181, -0.5517379884047073},
+		{0.8331701647019132, -0.5530167055800275},
+		{0.8323208677679297, -0.55429412145362},
+		{0.8314696123025453, -0.5555702330196022},
+		{0.8306164003088462, -0.5568450372751601},
+		{0.829761233794523, -0.5581185312205561},
+		{0.828904114771865, -0.5593907118591361},
+		{0.8280450452577557, -0.560661576197336},
+		{0.8271840272736691, -0.5619311212446895},
+		{0.8263210628456634, -0.5631993440138341},
+		{0.8254561540043776, -0.5644662415205195},
+		{0.8245893027850253, -0.5657318107836131},
+		{0.8237205112273913, -0.5669960488251087},
+		{0.8228497813758264, -0.5682589526701315},
+		{0.8219771152792417, -0.5695205193469471},
+		{0.8211025149911046, -0.5707807458869673},
+		{0.8202259825694347, -0.572039629324757},
+		{0.819347520076797, -0.5732971666980422},
+		{0.8184671295802987, -0.5745533550477158},
+		{0.8175848131515837, -0.5758081914178453},
+		{0.8167005728668278, -0.5770616728556794},
+		{0.8158144108067338, -0.5783137964116556},
+		{0.8149263290565266, -0.5795645591394056},
+		{0.8140363297059484, -0.5808139580957645},
+		{0.8131444148492536, -0.5820619903407754},
+		{0.812250586585204, -0.5833086529376983},
+		{0.8113548470170637, -0.5845539429530153},
+		{0.8104571982525948, -0.5857978574564389},
+		{0.8095576424040513, -0.587040393520918},
+		{0.808656181588175, -0.5882815482226452},
+		{0.8077528179261904, -0.5895213186410639},
+		{0.8068475535437993, -0.5907597018588742},
+		{0.8059403905711763, -0.591996694962041},
+		{0.8050313311429637, -0.5932322950397998},
+		{0.8041203773982658, -0.5944664991846644},
+		{0.8032075314806449, -0.5956993044924334},
+		{0.8022927955381157, -0.5969307080621965},
+		{0.8013761717231402, -0.5981607069963424},
+		{0.8004576621926228, -0.5993892984005645},
+		{0.799537269107905, -0.600616479383869},
+		{0.7986149946347609, -0.60184224705858},
+		{0.7976908409433912, -0.6030665985403482},
+		{0.7967648102084187, -0.604289530948156},
+		{0.7958369046088836, -0.6055110414043255},
+		{0.7949071263282369, -0.6067311270345245},
+		{0.7939754775543372, -0.6079497849677736},
+		{0.7930419604794436, -0.6091670123364532},
+		{0.7921065773002124, -0.6103828062763095},
+		{0.7911693302176902, -0.6115971639264619},
+		{0.79023022143731, -0.6128100824294097},
+		{0.7892892531688857, -0.6140215589310385},
+		{0.7883464276266063, -0.6152315905806268},
+		{0.7874017470290314, -0.6164401745308536},
+		{0.7864552135990859, -0.6176473079378039},
+		{0.7855068295640539, -0.6188529879609763},
+		{0.7845565971555752, -0.6200572117632891},
+		{0.7836045186096383, -0.6212599765110876},
+		{0.7826505961665757, -0.62246127937415},
+		{0.7816948320710595, -0.6236611175256945},
+		{0.7807372285720945, -0.6248594881423863},
+		{0.7797777879230146, -0.6260563884043435},
+		{0.778816512381476, -0.6272518154951441},
+		{0.7778534042094531, -0.6284457666018327},
+		{0.7768884656732324, -0.629638238914927},
+		{0.7759216990434077, -0.6308292296284245},
+		{0.7749531065948739, -0.6320187359398091},
+		{0.7739826906068228, -0.6332067550500572},
+		{0.773010453362737, -0.6343932841636455},
+		{0.7720363971503844, -0.6355783204885561},
+		{0.7710605242618138, -0.6367618612362842},
+		{0.7700828369933479, -0.637943903621844},
+		{0.7691033376455796, -0.6391244448637757},
+		{0.7681220285233654, -0.6403034821841517},
+		{0.7671389119358205, -0.6414810128085832},
+		{0.7661539901963129, -0.6426570339662269},
+		{0.765167265622459, -0.6438315428897914},
+		{0.7641787405361168, -0.6450045368155439},
+		{0.7631884172633813, -0.6461760129833163},
+		{0.7621962981345789, -0.6473459686365121},
+		{0.7612023854842618, -0.6485144010221124},
+		{0.7602066816512024, -0.6496813073906832},
+		{0.759209188978388, -0.650846684996381},
+		{0.7582099098130154, -0.6520105310969595},
+		{0.7572088465064846, -0.6531728429537768},
+		{0.7562060014143945, -0.6543336178318004},
+		{0.7552013768965364, -0.6554928529996153},
+		{0.7541949753168892, -0.656650545729429},
+		{0.7531867990436125, -0.6578066932970786},
+		{0.7521768504490427, -0.6589612929820373},
+		{0.7511651319096864, -0.6601143420674205},
+		{0.7501516458062151, -0.6612658378399923},
+		{0.7491363945234594, -0.6624157775901718},
+		{0.7481193804504036, -0.6635641586120398},
+		{0.7471006059801801, -0.6647109782033448},
+		{0.7460800735100638, -0.6658562336655097},
+		{0.745057785441466, -0.6669999223036375},
+		{0.7440337441799293, -0.6681420414265185},
+		{0.7430079521351217, -0.669282588346636},
+		{0.7419804117208311, -0.6704215603801731},
+		{0.7409511253549591, -0.6715589548470183},
+		{0.7399200954595162, -0.6726947690707729},
+		{0.7388873244606151, -0.673829000378756},
+		{0.7378528147884661, -0.674961646102012},
+		{0.7368165688773699, -0.6760927035753159},
+		{0.7357785891657136, -0.6772221701371803},
+		{0.7347388780959635, -0.6783500431298615},
+		{0.7336974381146604, -0.679476319899365},
+		{0.7326542716724129, -0.680600997795453},
+		{0.7316093812238926, -0.6817240741716497},
+		{0.7305627692278276, -0.6828455463852481},
+		{0.729514438146997, -0.6839654117973155},
+		{0.7284643904482252, -0.6850836677727004},
+		{0.7274126286023758, -0.6862003116800386},
+		{0.726359155084346, -0.687315340891759},
+		{0.7253039723730608, -0.6884287527840904},
+		{0.724247082951467, -0.6895405447370668},
+		{0.7231884893065275, -0.6906507141345346},
+		{0.7221281939292153, -0.6917592583641577},
+		{0.7210661993145081, -0.6928661748174246},
+		{0.7200025079613818, -0.6939714608896539},
+		{0.7189371223728045, -0.6950751139800009},
+		{0.7178700450557318, -0.696177131491463},
+		{0.7168012785210995, -0.6972775108308865},
+		{0.7157308252838186, -0.6983762494089729},
+		{0.7146586878627691, -0.6994733446402838},
+		{0.7135848687807935, -0.7005687939432483},
+		{0.7125093705646924, -0.7016625947401685},
+		{0.7114321957452164, -0.7027547444572253},
+		{0.7103533468570624, -0.7038452405244849},
+		{0.7092728264388657, -0.7049340803759049},
+		{0.7081906370331953, -0.7060212614493397},
+		{0.7071067811865476, -0.7071067811865475},
+		{0.7060212614493399, -0.7081906370331953},
+		{0.704934080375905, -0.7092728264388656},
+		{0.703845240524485, -0.7103533468570624},
+		{0.7027547444572254, -0.7114321957452164},
+		{0.7016625947401686, -0.7125093705646922},
+		{0.7005687939432484, -0.7135848687807935},
+		{0.6994733446402839, -0.7146586878627691},
+		{0.6983762494089729, -0.7157308252838186},
+		{0.6972775108308866, -0.7168012785210994},
+		{0.6961771314914631, -0.7178700450557317},
+		{0.6950751139800009, -0.7189371223728043},
+		{0.693971460889654, -0.7200025079613817},
+		{0.6928661748174247, -0.721066199314508},
+		{0.6917592583641579, -0.7221281939292153},
+		{0.6906507141345347, -0.7231884893065272},
+		{0.6895405447370669, -0.7242470829514668},
+		{0.6884287527840905, -0.7253039723730607},
+		{0.6873153408917592, -0.7263591550843459},
+		{0.6862003116800387, -0.7274126286023758},
+		{0.6850836677727005, -0.7284643904482252},
+		{0.6839654117973155, -0.7295144381469968},
+		{0.6828455463852481, -0.7305627692278276},
+		{0.6817240741716498, -0.7316093812238925},
+		{0.6806009977954531, -0.7326542716724128},
+		{0.6794763198993651, -0.7336974381146603},
+		{0.6783500431298616, -0.7347388780959634},
+		{0.6772221701371804, -0.7357785891657135},
+		{0.676092703575316, -0.7368165688773699},
+		{0.674961646102012, -0.737852814788466},
+		{0.6738290003787561, -0.738887324460615},
+		{0.672694769070773, -0.739920095459516},
+		{0.6715589548470184, -0.7409511253549591},
+		{0.6704215603801732, -0.741980411720831},
+		{0.6692825883466361, -0.7430079521351217},
+		{0.6681420414265186, -0.7440337441799293},
+		{0.6669999223036376, -0.745057785441466},
+		{0.6658562336655097, -0.7460800735100637},
+		{0.6647109782033449, -0.7471006059801801},
+		{0.6635641586120399, -0.7481193804504035},
+		{0.6624157775901718, -0.7491363945234593},
+		{0.6612658378399923, -0.750151645806215},
+		{0.6601143420674206, -0.7511651319096864},
+		{0.6589612929820373, -0.7521768504490427},
+		{0.6578066932970787, -0.7531867990436125},
+		{0.656650545729429, -0.7541949753168892},
+		{0.6554928529996155, -0.7552013768965364},
+		{0.6543336178318006, -0.7562060014143945},
+		{0.6531728429537769, -0.7572088465064845},
+		{0.6520105310969596, -0.7582099098130152},
+		{0.650846684996381, -0.7592091889783878},
+		{0.6496813073906833, -0.7602066816512024},
+		{0.6485144010221126, -0.7612023854842617},
+		{0.6473459686365122, -0.7621962981345789},
+		{0.6461760129833164, -0.7631884172633812},
+		{0.645004536815544, -0.7641787405361167},
+		{0.6438315428897915, -0.765167265622459},
+		{0.642657033966227, -0.7661539901963128},
+		{0.6414810128085832, -0.7671389119358204},
+		{0.6403034821841518, -0.7681220285233653},
+		{0.6391244448637758, -0.7691033376455796},
+		{0.6379439036218442, -0.7700828369933479},
+		{0.6367618612362843, -0.7710605242618137},
+		{0.6355783204885562, -0.7720363971503844},
+		{0.6343932841636456, -0.7730104533627369},
+		{0.6332067550500573, -0.7739826906068228},
+		{0.6320187359398091, -0.7749531065948738},
+		{0.6308292296284246, -0.7759216990434076},
+		{0.6296382389149271, -0.7768884656732324},
+		{0.6284457666018327, -0.777853404209453},
+		{0.6272518154951442, -0.7788165123814759},
+		{0.6260563884043436, -0.7797777879230144},
+		{0.6248594881423865, -0.7807372285720944},
+		{0.6236611175256946, -0.7816948320710594},
+		{0.6224612793741501, -0.7826505961665756},
+		{0.6212599765110877, -0.7836045186096382},
+		{0.6200572117632892, -0.7845565971555751},
+		{0.6188529879609764, -0.7855068295640539},
+		{0.617647307937804, -0.7864552135990858},
+		{0.6164401745308536, -0.7874017470290313},
+		{0.6152315905806269, -0.7883464276266062},
+		{0.6140215589310385, -0.7892892531688855},
+		{0.6128100824294098, -0.79023022143731},
+		{0.611597163926462, -0.7911693302176901},
+		{0.6103828062763095, -0.7921065773002123},
+		{0.6091670123364533, -0.7930419604794435},
+		{0.6079497849677737, -0.7939754775543371},
+		{0.6067311270345246, -0.794907126328237},
+		{0.6055110414043255, -0.7958369046088835},
+		{0.6042895309481561, -0.7967648102084187},
+		{0.6030665985403483, -0.797690840943391},
+		{0.6018422470585801, -0.7986149946347608},
+		{0.600616479383869, -0.7995372691079049},
+		{0.5993892984005647, -0.8004576621926227},
+		{0.5981607069963424, -0.8013761717231402},
+		{0.5969307080621965, -0.8022927955381157},
+		{0.5956993044924335, -0.8032075314806448},
+		{0.5944664991846645, -0.8041203773982657},
+		{0.5932322950397999, -0.8050313311429637},
+		{0.591996694962041, -0.8059403905711762},
+		{0.5907597018588743, -0.8068475535437992},
+		{0.5895213186410639, -0.8077528179261902},
+		{0.5882815482226453, -0.808656181588175},
+		{0.5870403935209181, -0.8095576424040513},
+		{0.5857978574564389, -0.8104571982525947},
+		{0.5845539429530154, -0.8113548470170636},
+		{0.5833086529376984, -0.8122505865852039},
+		{0.5820619903407755, -0.8131444148492535},
+		{0.5808139580957646, -0.8140363297059483},
+		{0.5795645591394057, -0.8149263290565265},
+		{0.5783137964116557, -0.8158144108067337},
+		{0.5770616728556796, -0.8167005728668277},
+		{0.5758081914178454, -0.8175848131515836},
+		{0.5745533550477159, -0.8184671295802987},
+		{0.5732971666980423, -0.8193475200767969},
+		{0.5720396293247572, -0.8202259825694346},
+		{0.5707807458869674, -0.8211025149911046},
+		{0.5695205193469473, -0.8219771152792416},
+		{0.5682589526701316, -0.8228497813758263},
+		{0.5669960488251087, -0.8237205112273913},
+		{0.5657318107836132, -0.8245893027850253},
+		{0.5644662415205195, -0.8254561540043774},
+		{0.5631993440138342, -0.8263210628456634},
+		{0.5619311212446895, -0.827184027273669},
+		{0.560661576197336, -0.8280450452577558},
+		{0.5593907118591361, -0.8289041147718649},
+		{0.5581185312205562, -0.829761233794523},
+		{0.5568450372751602, -0.8306164003088462},
+		{0.5555702330196023, -0.8314696123025452},
+		{0.5542941214536201, -0.8323208677679297},
+		{0.5530167055800276, -0.8331701647019131},
+		{0.5517379884047074, -0.8340175011060181},
+		{0.5504579729366049, -0.83486287498638},
+		{0.5491766621877198, -0.8357062843537526},
+		{0.5478940591731003, -0.8365477272235119},
+		{0.546610166910835, -0.8373872016156618},
+		{0.5453249884220466, -0.838224705554838},
+		{0.5440385267308839, -0.8390602370703126},
+		{0.542750784864516, -0.8398937941959994},
+		{0.5414617658531236, -0.840725374970458},
+		{0.540171472729893, -0.8415549774368983},
+		{0.5388799085310084, -0.8423825996431858},
+		{0.5375870762956455, -0.8432082396418453},
+		{0.5362929790659632, -0.8440318954900663},
+		{0.5349976198870974, -0.844853565249707},
+		{0.533701001807153, -0.8456732469872991},
+		{0.532403127877198, -0.8464909387740521},
+		{0.5311040011512551, -0.8473066386858582},
+		{0.5298036246862948, -0.8481203448032971},
+		{0.5285020015422285, -0.8489320552116396},
+		{0.5271991347819014, -0.8497417680008524},
+		{0.5258950274710849, -0.8505494812656034},
+		{0.524589682678469, -0.8513551931052652},
+		{0.5232831034756564, -0.8521589016239197},
+		{0.5219752929371544, -0.8529606049303636},
+		{0.5206662541403673, -0.8537603011381113},
+		{0.5193559901655896, -0.8545579883654005},
+		{0.5180445040959994, -0.8553536647351959},
+		{0.51673179901765, -0.8561473283751944},
+		{0.5154178780194631, -0.8569389774178287},
+		{0.5141027441932218, -0.8577286100002721},
+		{0.5127864006335631, -0.8585162242644427},
+		{0.5114688504379705, -0.8593018183570084},
+		{0.5101500967067668, -0.8600853904293901},
+		{0.5088301425431071, -0.8608669386377672},
+		{0.507508991052971, -0.8616464611430813},
+		{0.5061866453451555, -0.8624239561110405},
+		{0.5048631085312676, -0.8631994217121242},
+		{0.5035383837257176, -0.8639728561215866},
+		{0.5022124740457109, -0.8647442575194623},
+		{0.5008853826112409, -0.865513624090569},
+		{0.4995571125450819, -0.866280954024513},
+		{0.4982276669727819, -0.8670462455156926},
+		{0.4968970490226547, -0.8678094967633032},
+		{0.49556526182577254, -0.868570705971341},
+		{0.4942323085159598, -0.8693298713486068},
+		{0.49289819222978415, -0.8700869911087113},
+		{0.4915629161065501, -0.8708420634700789},
+		{0.49022648328829116, -0.871595086655951},
+		{0.4888888969197632, -0.8723460588943914},
+		{0.4875501601484361, -0.87309497841829},
+		{0.4862102761244866, -0.8738418434653668},
+		{0.4848692480007911, -0.8745866522781761},
+		{0.4835270789329188, -0.8753294031041108},
+		{0.4821837720791229, -0.8760700941954065},
+		{0.48083933060033396, -0.8768087238091457},
+		{0.47949375766015306, -0.8775452902072612},
+		{0.4781470564248431, -0.8782797916565415},
+		{0.47679923006332225, -0.8790122264286334},
+		{0.47545028174715587, -0.8797425928000474},
+		{0.47410021465055, -0.8804708890521608},
+		{0.4727490319503429, -0.8811971134712221},
+		{0.4713967368259978, -0.8819212643483549},
+		{0.4700433324595956, -0.8826433399795628},
+		{0.46868882203582796, -0.8833633386657316},
+		{0.4673332087419885, -0.884081258712635},
+		{0.4659764957679661, -0.8847970984309379},
+		{0.4646186863062378, -0.8855108561362},
+		{0.46325978355186026, -0.8862225301488806},
+		{0.46189979070246284, -0.8869321187943421},
+		{0.46053871095824, -0.8876396204028539},
+		{0.45917654752194415, -0.8883450333095962},
+		{0.4578133035988773, -0.8890483558546645},
+		{0.45644898239688386, -0.8897495863830728},
+		{0.45508358712634384, -0.8904487232447579},
+		{0.4537171210001639, -0.8911457647945833},
+		{0.452349587233771, -0.8918407093923426},
+		{0.4509809890451038, -0.8925335554027647},
+		{0.4496113296546066, -0.8932243011955153},
+		{0.44824061228522, -0.8939129451452033},
+		{0.4468688401623743, -0.8945994856313826},
+		{0.44549601651398174, -0.8952839210385576},
+		{0.44412214457042926, -0.8959662497561852},
+		{0.44274722756457013, -0.8966464701786802},
+		{0.4413712687317166, -0.8973245807054183},
+		{0.43999427130963326, -0.8980005797407399},
+		{0.4386162385385277, -0.8986744656939538},
+		{0.4372371736610442, -0.8993462369793415},
+		{0.4358570799222555, -0.9000158920161602},
+		{0.4344759605696557, -0.9006834292286469},
+		{0.433093818853152, -0.901348847046022},
+		{0.43171065802505737, -0.9020121439024932},
+		{0.4303264813400826, -0.9026733182372588},
+		{0.42894129205532955, -0.9033323684945118},
+		{0.4275550934302822, -0.9039892931234433},
+		{0.42616788872679956, -0.9046440905782461},
+		{0.4247796812091088, -0.9052967593181187},
+		{0.4233904741437961, -0.9059472978072685},
+		{0.4220002707997998, -0.9065957045149153},
+		{0.4206090744484025, -0.9072419779152958},
+		{0.41921688836322396, -0.9078861164876663},
+		{0.4178237158202124, -0.9085281187163061},
+		{0.4164295600976373, -0.9091679830905224},
+		{0.41503442447608163, -0.9098057081046523},
+		{0.41363831223843456, -0.9104412922580671},
+		{0.412241226669883, -0.9110747340551762},
+		{0.4108431710579039, -0.91170603200543},
+		{0.4094441486922576, -0.9123351846233227},
+		{0.40804416286497874, -0.9129621904283982},
+		{0.40664321687036914, -0.9135870479452508},
+		{0.4052413140049898, -0.9142097557035307},
+		{0.40383845756765413, -0.9148303122379462},
+		{0.40243465085941854, -0.9154487160882678},
+		{0.4010298971835758, -0.9160649657993316},
+		{0.3996241998456468, -0.9166790599210427},
+		{0.3982175621533736, -0.9172909970083779},
+		{0.3968099874167104, -0.9179007756213905},
+		{0.3954014789478163, -0.9185083943252123},
+		{0.3939920400610481, -0.9191138516900577},
+		{0.3925816740729515, -0.9197171462912272},
+		{0.391170384302254, -0.9203182767091106},
+		{0.3897581740698564, -0.9209172415291894},
+		{0.3883450466988263, -0.921514039342042},
+		{0.3869310055143887, -0.9221086687433452},
+		{0.385516053843919, -0.9227011283338785},
+		{0.38410019501693504, -0.9232914167195276},
+		{0.38268343236508984, -0.9238795325112867},
+		{0.3812657692221625, -0.9244654743252626},
+		{0.3798472089240511, -0.9250492407826776},
+		{0.37842775480876556, -0.9256308305098727},
+		{0.3770074102164183, -0.9262102421383113},
+		{0.3755861784892173, -0.9267874743045817},
+		{0.37416406297145793, -0.9273625256504011},
+		{0.3727410670095158, -0.9279353948226179},
+		{0.3713171939518376, -0.9285060804732156},
+		{0.3698924471489342, -0.9290745812593157},
+		{0.3684668299533723, -0.9296408958431812},
+		{0.36704034571976724, -0.930205022892219},
+		{0.36561299780477396, -0.9307669610789836},
+		{0.36418478956707984, -0.9313267090811804},
+		{0.3627557243673972, -0.9318842655816681},
+		{0.36132580556845434, -0.9324396292684624},
+		{0.3598950365349883, -0.9329927988347387},
+		{0.35846342063373654, -0.9335437729788363},
+		{0.35703096123343003, -0.9340925504042589},
+		{0.35559766170478396, -0.9346391298196808},
+		{0.3541635254204905, -0.9351835099389475},
+		{0.3527285557552107, -0.9357256894810804},
+		{0.35129275608556715, -0.9362656671702783},
+		{0.34985612979013503, -0.9368034417359216},
+		{0.3484186802494345, -0.937339011912575},
+		{0.3469804108459237, -0.9378723764399899},
+		{0.34554132496398915, -0.9384035340631081},
+		{0.344101425989939, -0.9389324835320645},
+		{0.3426607173119944, -0.9394592236021899},
+		{0.3412192023202824, -0.9399837530340139},
+		{0.33977688440682696, -0.9405060705932683},
+		{0.3383337669655413, -0.9410261750508893},
+		{0.33688985339222005, -0.9415440651830208},
+		{0.33544514708453166, -0.9420597397710174},
+		{0.3339996514420095, -0.9425731976014468},
+		{0.33255336986604417, -0.9430844374660935},
+		{0.33110630575987643, -0.9435934581619604},
+		{0.32965846252858755, -0.9441002584912727},
+		{0.32820984357909266, -0.9446048372614801},
+		{0.32676045232013173, -0.9451071932852606},
+		{0.325310292162263, -0.9456073253805213},
+		{0.32385936651785296, -0.9461052323704033},
+		{0.32240767880106996, -0.9466009130832835},
+		{0.3209552324278752, -0.9470943663527772},
+		{0.31950203081601575, -0.9475855910177412},
+		{0.31804807738501506, -0.9480745859222762},
+		{0.31659337555616585, -0.9485613499157304},
+		{0.31513792875252244, -0.9490458818527006},
+		{0.3136817403988915, -0.9495281805930367},
+		{0.31222481392182505, -0.950008245001843},
+		{0.3107671527496115, -0.9504860739494817},
+		{0.3093087603122687, -0.9509616663115751},
+		{0.307849640041535, -0.9514350209690083},
+		{0.3063897953708611, -0.9519061368079322},
+		{0.3049292297354024, -0.9523750127197659},
+		{0.30346794657201137, -0.9528416476011987},
+		{0.3020059493192282, -0.9533060403541938},
+		{0.3005432414172734, -0.9537681898859903},
+		{0.2990798263080405, -0.9542280951091057},
+		{0.2976157074350863, -0.9546857549413383},
+		{0.29615088824362396, -0.9551411683057707},
+		{0.2946853721805143, -0.9555943341307711},
+		{0.2932191626942587, -0.9560452513499964},
+		{0.2917522632349894, -0.956493918902395},
+		{0.2902846772544623, -0.9569403357322089},
+		{0.2888164082060495, -0.957384500788976},
+		{0.28734745954472957, -0.9578264130275328},
+		{0.2858778347270807, -0.9582660714080176},
+		{0.2844075372112718, -0.9587034748958715},
+		{0.2829365704570554, -0.9591386224618419},
+		{0.28146493792575805, -0.9595715130819845},
+		{0.2799926430802734, -0.9600021457376658},
+		{0.27851968938505306, -0.9604305194155658},
+		{0.27704608030609995, -0.9608566331076797},
+		{0.27557181931095825, -0.9612804858113206},
+		{0.27409690986870633, -0.9617020765291225},
+		{0.272621355449949, -0.9621214042690416},
+		{0.27114515952680807, -0.9625384680443592},
+		{0.2696683255729152, -0.9629532668736839},
+		{0.2681908570634031, -0.9633657997809542},
+		{0.2667127574748984, -0.9637760657954398},
+		{0.2652340302855119, -0.9641840639517457},
+		{0.2637546789748315, -0.9645897932898128},
+		{0.2622747070239136, -0.9649932528549203},
+		{0.26079411791527557, -0.9653944416976894},
+		{0.25931291513288635, -0.9657933588740836},
+		{0.25783110216215893, -0.9661900034454125},
+		{0.2563486824899429, -0.9665843744783331},
+		{0.2548656596045146, -0.9669764710448521},
+		{0.25338203699557027, -0.9673662922223284},
+		{0.2518978181542169, -0.9677538370934755},
+		{0.2504130065729653, -0.9681391047463623},
+		{0.24892760574572026, -0.9685220942744173},
+		{0.24744161916777344, -0.9689028047764289},
+		{0.2459550503357946, -0.9692812353565485},
+		{0.2444679027478242, -0.9696573851242924},
+		{0.24298017990326398, -0.970031253194544},
+		{0.24149188530286927, -0.9704028386875555},
+		{0.2400030224487415, -0.9707721407289502},
+		{0.2385135948443185, -0.9711391584497251},
+		{0.23702360599436734, -0.9715038909862518},
+		{0.23553305940497546, -0.9718663374802794},
+		{0.23404195858354346, -0.9722264970789363},
+		{0.23255030703877533, -0.9725843689347322},
+		{0.23105810828067125, -0.9729399522055602},
+		{0.22956536582051887, -0.9732932460546981},
+		{0.2280720831708858, -0.9736442496508119},
+		{0.2265782638456101, -0.973992962167956},
+		{0.22508391135979278, -0.9743393827855759},
+		{0.22358902922979, -0.9746835106885107},
+		{0.2220936209732036, -0.9750253450669941},
+		{0.22059769010887365, -0.975364885116657},
+		{0.21910124015686977, -0.9757021300385286},
+		{0.21760427463848367, -0.9760370790390391},
+		{0.2161067970762196, -0.9763697313300211},
+		{0.21460881099378692, -0.9767000861287118},
+		{0.21311031991609136, -0.9770281426577543},
+		{0.2116113273692276, -0.9773539001452},
+		{0.21011183688046972, -0.9776773578245099},
+		{0.20861185197826343, -0.9779985149345571},
+		{0.20711137619221856, -0.9783173707196277},
+		{0.20561041305309932, -0.9786339244294231},
+		{0.204108966092817, -0.9789481753190622},
+		{0.2026070388444211, -0.9792601226490821},
+		{0.20110463484209193, -0.9795697656854406},
+		{0.19960175762113105, -0.9798771036995176},
+		{0.19809841071795373, -0.9801821359681173},
+		{0.19659459767008022, -0.9804848617734694},
+		{0.1950903220161283, -0.9807852804032304},
+		{0.19358558729580372, -0.9810833911504867},
+		{0.19208039704989238, -0.9813791933137546},
+		{0.19057475482025277, -0.9816726861969831},
+		{0.18906866414980628, -0.9819638691095552},
+		{0.18756212858252974, -0.9822527413662894},
+		{0.1860551516634466, -0.9825393022874412},
+		{0.18454773693861964, -0.9828235511987053},
+		{0.18303988795514103, -0.9831054874312164},
+		{0.18153160826112513, -0.9833851103215512},
+		{0.18002290140569951, -0.9836624192117303},
+		{0.17851377093899756, -0.9839374134492189},
+		{0.17700422041214886, -0.984210092386929},
+		{0.17549425337727137, -0.9844804553832209},
+		{0.17398387338746385, -0.9847485018019042},
+		{0.17247308399679603, -0.9850142310122398},
+		{0.17096188876030136, -0.9852776423889412},
+		{0.16945029123396793, -0.9855387353121761},
+		{0.1679382949747312, -0.9857975091675674},
+		{0.16642590354046422, -0.9860539633461954},
+		{0.16491312048997006, -0.9863080972445987},
+		{0.16339994938297323, -0.9865599102647755},
+		{0.16188639378011188, -0.9868094018141855},
+		{0.1603724572429284, -0.987056571305751},
+		{0.1588581433338614, -0.9873014181578584},
+		{0.15734345561623828, -0.9875439417943592},
+		{0.15582839765426532, -0.9877841416445722},
+		{0.15431297301302024, -0.9880220171432835},
+		{0.1527971852584434, -0.9882575677307495},
+		{0.15128103795733025, -0.9884907928526967},
+		{0.14976453467732162, -0.9887216919603238},
+		{0.1482476789868962, -0.988950264510303},
+		{0.14673047445536175, -0.989176509964781},
+		{0.14521292465284752, -0.9894004277913803},
+		{0.14369503315029458, -0.9896220174632008},
+		{0.142176803519448, -0.9898412784588205},
+		{0.14065823933284924, -0.9900582102622971},
+		{0.13913934416382628, -0.9902728123631691},
+		{0.13762012158648618, -0.990485084256457},
+		{0.13610057517570617, -0.9906950254426646},
+		{0.13458070850712622, -0.99090263542778},
+		{0.13306052515713918, -0.9911079137232768},
+		{0.13154002870288325, -0.9913108598461153},
+		{0.13001922272223335, -0.991511473318744},
+		{0.12849811079379322, -0.9917097536690995},
+		{0.12697669649688598, -0.9919057004306093},
+		{0.1254549834115462, -0.9920993131421918},
+		{0.12393297511851219, -0.9922905913482573},
+		{0.12241067519921628, -0.9924795345987101},
+		{0.12088808723577722, -0.992666142448948},
+		{0.11936521481099134, -0.992850414459865},
+		{0.11784206150832502, -0.9930323501978514},
+		{0.11631863091190486, -0.9932119492347945},
+		{0.11479492660651025, -0.9933892111480807},
+		{0.11327095217756435, -0.9935641355205953},
+		{0.11174671121112666, -0.9937367219407246},
+		{0.11022220729388318, -0.9939069700023561},
+		{0.10869744401313867, -0.9940748793048794},
+		{0.10717242495680887, -0.994240449453188},
+		{0.1056471537134107, -0.9944036800576791},
+		{0.10412163387205473, -0.9945645707342554},
+		{0.10259586902243627, -0.9947231211043257},
+		{0.10106986275482786, -0.9948793307948056},
+		{0.09954361866006943, -0.9950331994381186},
+		{0.09801714032956077, -0.9951847266721968},
+		{0.09649043135525259, -0.9953339121404823},
+		{0.09496349532963906, -0.9954807554919269},
+		{0.09343633584574791, -0.9956252563809943},
+		{0.0919089564971327, -0.9957674144676598},
+		{0.09038136087786501, -0.9959072294174116},
+		{0.08885355258252468, -0.996044700901252},
+		{0.08732553520619221, -0.9961798285956969},
+		{0.08579731234443988, -0.996312612182778},
+		{0.08426888759332411, -0.9964430513500426},
+		{0.0827402645493758, -0.9965711457905548},
+		{0.08121144680959239, -0.996696895202896},
+		{0.07968243797143013, -0.9968202992911658},
+		{0.0781532416327943, -0.996941357764982},
+		{0.07662386139203162, -0.997060070339483},
+		{0.07509430084792128, -0.9971764367353261},
+		{0.07356456359966745, -0.9972904566786902},
+		{0.07203465324688942, -0.9974021299012753},
+		{0.07050457338961401, -0.9975114561403035},
+		{0.06897432762826673, -0.9976184351385196},
+		{0.0674439195636641, -0.9977230666441916},
+		{0.06591335279700392, -0.9978253504111116},
+		{0.06438263092985741, -0.997925286198596},
+		{0.06285175756416142, -0.9980228737714862},
+		{0.061320736302208655, -0.9981181129001492},
+		{0.05978957074664001, -0.9982110033604781},
+		{0.05825826450043573, -0.9983015449338929},
+		{0.05672682116690778, -0.9983897374073403},
+		{0.05519524434969003, -0.9984755805732948},
+		{0.05366353765273068, -0.9985590742297593},
+		{0.05213170468028332, -0.9986402181802653},
+		{0.05059974903689934, -0.9987190122338729},
+		{0.04906767432741813, -0.9987954562051724},
+		{0.04753548415695926, -0.9988695499142836},
+		{0.046003182130914644, -0.9989412931868569},
+		{0.044470771854938744, -0.9990106858540734},
+		{0.04293825693494096, -0.9990777277526454},
+		{0.04140564097707672, -0.9991424187248169},
+		{0.039872927587739845, -0.9992047586183639},
+		{0.03834012037355279, -0.9992647472865944},
+		{0.03680722294135899, -0.9993223845883494},
+		{0.03527423889821395, -0.9993776703880028},
+		{0.03374117185137764, -0.9994306045554617},
+		{0.032208025408304704, -0.999481186966167},
+		{0.030674803176636584, -0.9995294175010931},
+		{0.029141508764193743, -0.9995752960467492},
+		{0.02760814577896582, -0.9996188224951786},
+		{0.02607471782910404, -0.9996599967439592},
+		{0.024541228522912267, -0.9996988186962041},
+		{0.02300768146883941, -0.9997352882605618},
+		{0.02147408027546961, -0.9997694053512153},
+		{0.0199404285515146, -0.9998011698878841},
+		{0.01840672990580482, -0.9998305817958234},
+		{0.016872987947281773, -0.9998576410058239},
+		{0.01533920628498822, -0.9998823474542126},
+		{0.013805388528060349, -0.9999047010828528},
+		{0.012271538285719944, -0.9999247018391446},
+		{0.01073765916726457, -0.9999423496760239},
+		{0.00920375478205996, -0.9999576445519639},
+		{0.007669828739531077, -0.999970586430974},
+		{0.006135884649154516, -0.9999811752826011},
+		{0.004601926120448672, -0.9999894110819284},
+		{0.003067956762966138, -0.9999952938095762},
+		{0.001533980186284766, -0.9999988234517019},
+		{0, -1},
+		{-0.0015339801862847655, -0.9999988234517019},
+		{-0.003067956762965976, -0.9999952938095762},
+		{-0.0046019261204485705, -0.9999894110819284},
+		{-0.006135884649154475, -0.9999811752826011},
+		{-0.007669828739531097, -0.999970586430974},
+		{-0.00920375478205982, -0.9999576445519639},
+		{-0.01073765916726449, -0.9999423496760239},
+		{-0.012271538285719925, -0.9999247018391446},
+		{-0.01380538852806039, -0.9999047010828528},
+		{-0.0153392062849881, -0.9998823474542126},
+		{-0.01687298794728171, -0.9998576410058239},
+		{-0.01840672990580482, -0.9998305817958234},
+		{-0.01994042855151444, -0.9998011698878841},
+		{-0.021474080275469508, -0.9997694053512153},
+		{-0.02300768146883937, -0.9997352882605618},
+		{-0.024541228522912288, -0.9996988186962041},
+		{-0.0260747178291039, -0.9996599967439592},
+		{-0.02760814577896574, -0.9996188224951786},
+		{-0.029141508764193722, -0.9995752960467492},
+		{-0.030674803176636626, -0.9995294175010931},
+		{-0.032208025408304586, -0.999481186966167},
+		{-0.03374117185137758, -0.9994306045554617},
+		{-0.03527423889821395, -0.9993776703880028},
+		{-0.03680722294135883, -0.9993223845883494},
+		{-0.038340120373552694, -0.9992647472865944},
+		{-0.03987292758773981, -0.9992047586183639},
+		{-0.04140564097707674, -0.9991424187248169},
+		{-0.04293825693494082, -0.9990777277526454},
+		{-0.04447077185493867, -0.9990106858540734},
+		{-0.04600318213091462, -0.9989412931868569},
+		{-0.0475354841569593, -0.9988695499142836},
+		{-0.049067674327418015, -0.9987954562051724},
+		{-0.05059974903689928, -0.9987190122338729},
+		{-0.052131704680283324, -0.9986402181802653},
+		{-0.05366353765273052, -0.9985590742297593},
+		{-0.05519524434968994, -0.9984755805732948},
+		{-0.05672682116690775, -0.9983897374073403},
+		{-0.05825826450043575, -0.9983015449338929},
+		{-0.05978957074663987, -0.9982110033604781},
+		{-0.06132073630220858, -0.9981181129001492},
+		{-0.0628517575641614, -0.9980228737714862},
+		{-0.06438263092985747, -0.997925286198596},
+		{-0.0659133527970038, -0.9978253504111116},
+		{-0.06744391956366405, -0.9977230666441916},
+		{-0.06897432762826675, -0.9976184351385196},
+		{-0.07050457338961386, -0.9975114561403035},
+		{-0.07203465324688933, -0.9974021299012753},
+		{-0.07356456359966743, -0.9972904566786902},
+		{-0.0750943008479213, -0.9971764367353261},
+		{-0.07662386139203149, -0.997060070339483},
+		{-0.07815324163279423, -0.9969413577649822},
+		{-0.07968243797143013, -0.9968202992911658},
+		{-0.08121144680959244, -0.996696895202896},
+		{-0.08274026454937569, -0.996571145790555},
+		{-0.08426888759332407, -0.9964430513500426},
+		{-0.0857973123444399, -0.996312612182778},
+		{-0.08732553520619206, -0.9961798285956969},
+		{-0.0888535525825246, -0.996044700901252},
+		{-0.09038136087786498, -0.9959072294174117},
+		{-0.09190895649713272, -0.9957674144676598},
+		{-0.09343633584574779, -0.9956252563809943},
+		{-0.09496349532963899, -0.9954807554919269},
+		{-0.09649043135525259, -0.9953339121404823},
+		{-0.0980171403295606, -0.9951847266721968},
+		{-0.09954361866006932, -0.9950331994381186},
+		{-0.10106986275482782, -0.9948793307948056},
+		{-0.10259586902243628, -0.9947231211043257},
+		{-0.10412163387205459, -0.9945645707342554},
+		{-0.10564715371341062, -0.9944036800576791},
+		{-0.10717242495680884, -0.994240449453188},
+		{-0.10869744401313872, -0.9940748793048794},
+		{-0.11022220729388306, -0.9939069700023561},
+		{-0.11174671121112659, -0.9937367219407246},
+		{-0.11327095217756435, -0.9935641355205953},
+		{-0.11479492660651008, -0.9933892111480807},
+		{-0.11631863091190475, -0.9932119492347945},
+		{-0.11784206150832498, -0.9930323501978514},
+		{-0.11936521481099135, -0.992850414459865},
+		{-0.12088808723577708, -0.992666142448948},
+		{-0.1224106751992162, -0.9924795345987101},
+		{-0.12393297511851216, -0.9922905913482573},
+		{-0.12545498341154623, -0.9920993131421918},
+		{-0.12697669649688587, -0.9919057004306093},
+		{-0.12849811079379317, -0.9917097536690995},
+		{-0.13001922272223335, -0.991511473318744},
+		{-0.13154002870288312, -0.9913108598461154},
+		{-0.13306052515713906, -0.9911079137232768},
+		{-0.13458070850712617, -0.99090263542778},
+		{-0.1361005751757062, -0.9906950254426646},
+		{-0.13762012158648604, -0.9904850842564571},
+		{-0.1391393441638262, -0.9902728123631691},
+		{-0.1406582393328492, -0.9900582102622971},
+		{-0.14217680351944803, -0.9898412784588205},
+		{-0.14369503315029447, -0.9896220174632009},
+		{-0.14521292465284746, -0.9894004277913803},
+		{-0.14673047445536175, -0.989176509964781},
+		{-0.14824767898689603, -0.988950264510303},
+		{-0.1497645346773215, -0.9887216919603238},
+		{-0.15128103795733022, -0.9884907928526967},
+		{-0.15279718525844344, -0.9882575677307495},
+		{-0.1543129730130201, -0.9880220171432835},
+		{-0.15582839765426523, -0.9877841416445722},
+		{-0.15734345561623825, -0.9875439417943592},
+		{-0.15885814333386145, -0.9873014181578584},
+		{-0.16037245724292828, -0.987056571305751},
+		{-0.16188639378011183, -0.9868094018141855},
+		{-0.16339994938297323, -0.9865599102647755},
+		{-0.16491312048996992, -0.9863080972445987},
+		{-0.1664259035404641, -0.9860539633461954},
+		{-0.16793829497473117, -0.9857975091675674},
+		{-0.16945029123396796, -0.9855387353121761},
+		{-0.17096188876030122, -0.9852776423889412},
+		{-0.17247308399679595, -0.9850142310122398},
+		{-0.17398387338746382, -0.9847485018019042},
+		{-0.17549425337727143, -0.9844804553832209},
+		{-0.17700422041214875, -0.984210092386929},
+		{-0.1785137709389975, -0.9839374134492189},
+		{-0.18002290140569951, -0.9836624192117303},
+		{-0.18153160826112497, -0.9833851103215512},
+		{-0.18303988795514095, -0.9831054874312164},
+		{-0.18454773693861962, -0.9828235511987053},
+		{-0.18605515166344663, -0.9825393022874412},
+		{-0.1875621285825296, -0.9822527413662894},
+		{-0.1890686641498062, -0.9819638691095552},
+		{-0.19057475482025274, -0.9816726861969831},
+		{-0.19208039704989244, -0.9813791933137546},
+		{-0.1935855872958036, -0.9810833911504867},
+		{-0.19509032201612825, -0.9807852804032304},
+		{-0.19659459767008022, -0.9804848617734694},
+		{-0.19809841071795356, -0.9801821359681173},
+		{-0.19960175762113097, -0.9798771036995176},
+		{-0.2011046348420919, -0.9795697656854406},
+		{-0.20260703884442113, -0.9792601226490821},
+		{-0.20410896609281687, -0.9789481753190622},
+		{-0.20561041305309924, -0.9786339244294232},
+		{-0.20711137619221856, -0.9783173707196277},
+		{-0.20861185197826349, -0.9779985149345571},
+		{-0.2101118368804696, -0.9776773578245099},
+		{-0.21161132736922755, -0.9773539001452},
+		{-0.21311031991609136, -0.9770281426577543},
+		{-0.21460881099378676, -0.9767000861287118},
+		{-0.21610679707621952, -0.9763697313300211},
+		{-0.21760427463848364, -0.9760370790390391},
+		{-0.2191012401568698, -0.9757021300385286},
+		{-0.2205976901088735, -0.975364885116657},
+		{-0.2220936209732035, -0.9750253450669941},
+		{-0.22358902922979, -0.9746835106885107},
+		{-0.22508391135979283, -0.9743393827855759},
+		{-0.22657826384561, -0.973992962167956},
+		{-0.22807208317088573, -0.9736442496508119},
+		{-0.22956536582051887, -0.9732932460546981},
+		{-0.2310581082806711, -0.9729399522055602},
+		{-0.23255030703877524, -0.9725843689347323},
+		{-0.23404195858354343, -0.9722264970789363},
+		{-0.2355330594049755, -0.9718663374802794},
+		{-0.2370236059943672, -0.9715038909862518},
+		{-0.23851359484431842, -0.9711391584497251},
+		{-0.2400030224487415, -0.9707721407289502},
+		{-0.24149188530286933, -0.9704028386875555},
+		{-0.24298017990326387, -0.9700312531945441},
+		{-0.24446790274782415, -0.9696573851242924},
+		{-0.2459550503357946, -0.9692812353565485},
+		{-0.24744161916777327, -0.9689028047764289},
+		{-0.24892760574572015, -0.9685220942744173},
+		{-0.2504130065729652, -0.9681391047463624},
+		{-0.25189781815421697, -0.9677538370934755},
+		{-0.25338203699557016, -0.9673662922223285},
+		{-0.25486565960451457, -0.9669764710448521},
+		{-0.2563486824899429, -0.9665843744783331},
+		{-0.257831102162159, -0.9661900034454125},
+		{-0.25931291513288623, -0.9657933588740837},
+		{-0.2607941179152755, -0.9653944416976894},
+		{-0.2622747070239136, -0.9649932528549203},
+		{-0.26375467897483135, -0.9645897932898128},
+		{-0.2652340302855118, -0.9641840639517458},
+		{-0.26671275747489837, -0.9637760657954398},
+		{-0.2681908570634032, -0.963365799780954},
+		{-0.2696683255729151, -0.9629532668736839},
+		{-0.271145159526808, -0.9625384680443592},
+		{-0.272621355449949, -0.9621214042690416},
+		{-0.2740969098687064, -0.9617020765291225},
+		{-0.27557181931095814, -0.9612804858113206},
+		{-0.2770460803060999, -0.9608566331076797},
+		{-0.27851968938505306, -0.9604305194155658},
+		{-0.2799926430802732, -0.960002145737666},
+		{-0.28146493792575794, -0.9595715130819845},
+		{-0.2829365704570554, -0.9591386224618419},
+		{-0.2844075372112719, -0.9587034748958715},
+		{-0.2858778347270806, -0.9582660714080177},
+		{-0.2873474595447295, -0.9578264130275328},
+		{-0.2888164082060495, -0.957384500788976},
+		{-0.29028467725446233, -0.9569403357322088},
+		{-0.29175226323498926, -0.9564939189023951},
+		{-0.29321916269425863, -0.9560452513499964},
+		{-0.2946853721805143, -0.9555943341307711},
+		{-0.2961508882436238, -0.9551411683057708},
+		{-0.2976157074350862, -0.9546857549413383},
+		{-0.2990798263080405, -0.9542280951091057},
+		{-0.30054324141727345, -0.9537681898859903},
+		{-0.3020059493192281, -0.9533060403541938},
+		{-0.3034679465720113, -0.9528416476011987},
+		{-0.3049292297354024, -0.9523750127197659},
+		{-0.3063897953708609, -0.9519061368079322},
+		{-0.30784964004153487, -0.9514350209690083},
+		{-0.3093087603122687, -0.9509616663115752},
+		{-0.3107671527496115, -0.9504860739494817},
+		{-0.3122248139218249, -0.9500082450018431},
+		{-0.3136817403988915, -0.9495281805930367},
+		{-0.31513792875252244, -0.9490458818527006},
+		{-0.31659337555616585, -0.9485613499157303},
+		{-0.31804807738501495, -0.9480745859222762},
+		{-0.3195020308160157, -0.9475855910177412},
+		{-0.3209552324278752, -0.9470943663527772},
+		{-0.32240767880106985, -0.9466009130832836},
+		{-0.32385936651785285, -0.9461052323704033},
+		{-0.3253102921622629, -0.9456073253805213},
+		{-0.32676045232013173, -0.9451071932852606},
+		{-0.3282098435790925, -0.9446048372614803},
+		{-0.3296584625285875, -0.9441002584912727},
+		{-0.33110630575987643, -0.9435934581619604},
+		{-0.3325533698660442, -0.9430844374660935},
+		{-0.3339996514420094, -0.9425731976014469},
+		{-0.3354451470845316, -0.9420597397710174},
+		{-0.33688985339222005, -0.9415440651830208},
+		{-0.3383337669655411, -0.9410261750508893},
+		{-0.33977688440682685, -0.9405060705932684},
+		{-0.34121920232028236, -0.9399837530340139},
+		{-0.3426607173119944, -0.9394592236021899},
+		{-0.3441014259899388, -0.9389324835320645},
+		{-0.3455413249639891, -0.9384035340631081},
+		{-0.3469804108459237, -0.9378723764399899},
+		{-0.34841868024943456, -0.937339011912575},
+		{-0.3498561297901349, -0.9368034417359216},
+		{-0.3512927560855671, -0.9362656671702783},
+		{-0.3527285557552107, -0.9357256894810804},
+		{-0.35416352542049034, -0.9351835099389476},
+		{-0.35559766170478385, -0.9346391298196808},
+		{-0.35703096123343, -0.934092550404259},
+		{-0.35846342063373654, -0.9335437729788363},
+		{-0.3598950365349881, -0.9329927988347388},
+		{-0.3613258055684543, -0.9324396292684624},
+		{-0.3627557243673972, -0.9318842655816681},
+		{-0.3641847895670799, -0.9313267090811804},
+		{-0.36561299780477385, -0.9307669610789837},
+		{-0.3670403457197672, -0.930205022892219},
+		{-0.3684668299533723, -0.9296408958431812},
+		{-0.3698924471489341, -0.9290745812593159},
+		{-0.37131719395183754, -0.9285060804732156},
+		{-0.37274106700951576, -0.9279353948226179},
+		{-0.37416406297145793, -0.9273625256504011},
+		{-0.3755861784892172, -0.9267874743045819},
+		{-0.37700741021641826, -0.9262102421383114},
+		{-0.37842775480876556, -0.9256308305098727},
+		{-0.37984720892405116, -0.9250492407826776},
+		{-0.3812657692221624, -0.9244654743252626},
+		{-0.3826834323650898, -0.9238795325112867},
+		{-0.38410019501693504, -0.9232914167195276},
+		{-0.38551605384391885, -0.9227011283338785},
+		{-0.3869310055143886, -0.9221086687433452},
+		{-0.38834504669882625, -0.921514039342042},
+		{-0.3897581740698564, -0.9209172415291894},
+		{-0.39117038430225387, -0.9203182767091106},
+		{-0.39258167407295147, -0.9197171462912272},
+		{-0.3939920400610481, -0.9191138516900577},
+		{-0.39540147894781635, -0.9185083943252121},
+		{-0.3968099874167103, -0.9179007756213905},
+		{-0.39821756215337356, -0.9172909970083779},
+		{-0.3996241998456468, -0.9166790599210427},
+		{-0.4010298971835756, -0.9160649657993317},
+		{-0.40243465085941843, -0.9154487160882678},
+		{-0.4038384575676541, -0.9148303122379462},
+		{-0.40524131400498986, -0.9142097557035307},
+		{-0.40664321687036903, -0.9135870479452509},
+		{-0.4080441628649787, -0.9129621904283982},
+		{-0.4094441486922576, -0.9123351846233227},
+		{-0.4108431710579039, -0.9117060320054299},
+		{-0.4122412266698829, -0.9110747340551764},
+		{-0.41363831223843456, -0.9104412922580671},
+		{-0.41503442447608163, -0.9098057081046523},
+		{-0.41642956009763715, -0.9091679830905225},
+		{-0.41782371582021227, -0.9085281187163062},
+		{-0.4192168883632239, -0.9078861164876663},
+		{-0.4206090744484025, -0.9072419779152958},
+		{-0.4220002707997997, -0.9065957045149153},
+		{-0.42339047414379605, -0.9059472978072685},
+		{-0.4247796812091088, -0.9052967593181187},
+		{-0.4261678887267996, -0.9046440905782461},
+		{-0.4275550934302821, -0.9039892931234433},
+		{-0.4289412920553295, -0.9033323684945118},
+		{-0.4303264813400826, -0.9026733182372588},
+		{-0.43171065802505726, -0.9020121439024933},
+		{-0.43309381885315196, -0.901348847046022},
+		{-0.43447596056965565, -0.900683429228647},
+		{-0.4358570799222555, -0.9000158920161602},
+		{-0.4372371736610441, -0.8993462369793415},
+		{-0.43861623853852766, -0.8986744656939538},
+		{-0.43999427130963326, -0.8980005797407399},
+		{-0.44137126873171667, -0.8973245807054183},
+		{-0.44274722756457, -0.8966464701786802},
+		{-0.4441221445704292, -0.8959662497561852},
+		{-0.44549601651398174, -0.8952839210385576},
+		{-0.44686884016237416, -0.8945994856313827},
+		{-0.4482406122852199, -0.8939129451452033},
+		{-0.44961132965460654, -0.8932243011955153},
+		{-0.45098098904510386, -0.8925335554027647},
+		{-0.4523495872337709, -0.8918407093923427},
+		{-0.45371712100016387, -0.8911457647945833},
+		{-0.45508358712634384, -0.8904487232447579},
+		{-0.4564489823968839, -0.8897495863830728},
+		{-0.45781330359887723, -0.8890483558546646},
+		{-0.4591765475219441, -0.8883450333095964},
+		{-0.46053871095824, -0.8876396204028539},
+		{-0.46189979070246273, -0.8869321187943422},
+		{-0.46325978355186015, -0.8862225301488807},
+		{-0.4646186863062378, -0.8855108561362},
+		{-0.4659764957679662, -0.8847970984309378},
+		{-0.46733320874198847, -0.884081258712635},
+		{-0.46868882203582796, -0.8833633386657316},
+		{-0.4700433324595956, -0.8826433399795628},
+		{-0.47139673682599764, -0.881921264348355},
+		{-0.4727490319503428, -0.8811971134712221},
+		{-0.47410021465054997, -0.8804708890521608},
+		{-0.47545028174715587, -0.8797425928000474},
+		{-0.4767992300633221, -0.8790122264286335},
+		{-0.478147056424843, -0.8782797916565416},
+		{-0.479493757660153, -0.8775452902072614},
+		{-0.48083933060033396, -0.8768087238091457},
+		{-0.4821837720791227, -0.8760700941954066},
+		{-0.48352707893291874, -0.8753294031041109},
+		{-0.48486924800079106, -0.8745866522781761},
+		{-0.4862102761244864, -0.8738418434653668},
+		{-0.487550160148436, -0.8730949784182901},
+		{-0.48888889691976317, -0.8723460588943914},
+		{-0.49022648328829116, -0.871595086655951},
+		{-0.4915629161065499, -0.870842063470079},
+		{-0.49289819222978404, -0.8700869911087113},
+		{-0.4942323085159597, -0.8693298713486068},
+		{-0.49556526182577254, -0.868570705971341},
+		{-0.49689704902265447, -0.8678094967633033},
+		{-0.49822766697278187, -0.8670462455156926},
+		{-0.49955711254508184, -0.8662809540245131},
+		{-0.5008853826112407, -0.8655136240905691},
+		{-0.5022124740457108, -0.8647442575194623},
+		{-0.5035383837257176, -0.8639728561215867},
+		{-0.5048631085312676, -0.8631994217121242},
+		{-0.5061866453451552, -0.8624239561110405},
+		{-0.5075089910529709, -0.8616464611430813},
+		{-0.508830142543107, -0.8608669386377673},
+		{-0.5101500967067668, -0.8600853904293901},
+		{-0.5114688504379703, -0.8593018183570085},
+		{-0.512786400633563, -0.8585162242644427},
+		{-0.5141027441932217, -0.8577286100002721},
+		{-0.5154178780194629, -0.8569389774178287},
+		{-0.5167317990176499, -0.8561473283751945},
+		{-0.5180445040959993, -0.855353664735196},
+		{-0.5193559901655896, -0.8545579883654005},
+		{-0.5206662541403672, -0.8537603011381113},
+		{-0.5219752929371544, -0.8529606049303636},
+		{-0.5232831034756564, -0.8521589016239198},
+		{-0.524589682678469, -0.8513551931052652},
+		{-0.5258950274710846, -0.8505494812656034},
+		{-0.5271991347819014, -0.8497417680008524},
+		{-0.5285020015422285, -0.8489320552116396},
+		{-0.5298036246862946, -0.8481203448032972},
+		{-0.531104001151255, -0.8473066386858583},
+		{-0.5324031278771979, -0.8464909387740521},
+		{-0.533701001807153, -0.8456732469872991},
+		{-0.5349976198870972, -0.8448535652497071},
+		{-0.5362929790659632, -0.8440318954900664},
+		{-0.5375870762956454, -0.8432082396418454},
+		{-0.5388799085310084, -0.8423825996431858},
+		{-0.5401714727298929, -0.8415549774368984},
+		{-0.5414617658531234, -0.8407253749704581},
+		{-0.5427507848645159, -0.8398937941959995},
+		{-0.5440385267308838, -0.8390602370703127},
+		{-0.5453249884220465, -0.8382247055548381},
+		{-0.5466101669108349, -0.8373872016156619},
+		{-0.5478940591731002, -0.8365477272235119},
+		{-0.5491766621877197, -0.8357062843537526},
+		{-0.5504579729366048, -0.8348628749863801},
+		{-0.5517379884047073, -0.8340175011060181},
+		{-0.5530167055800275, -0.8331701647019132},
+		{-0.55429412145362, -0.8323208677679297},
+		{-0.5555702330196022, -0.8314696123025453},
+		{-0.5568450372751601, -0.8306164003088462},
+		{-0.5581185312205561, -0.829761233794523},
+		{-0.5593907118591361, -0.828904114771865},
+		{-0.560661576197336, -0.8280450452577557},
+		{-0.5619311212446895, -0.8271840272736691},
+		{-0.5631993440138341, -0.8263210628456634},
+		{-0.5644662415205195, -0.8254561540043776},
+		{-0.5657318107836131, -0.8245893027850253},
+		{-0.5669960488251087, -0.8237205112273913},
+		{-0.5682589526701315, -0.8228497813758264},
+		{-0.5695205193469471, -0.8219771152792417},
+		{-0.5707807458869673, -0.8211025149911046},
+		{-0.572039629324757, -0.8202259825694347},
+		{-0.5732971666980422, -0.819347520076797},
+		{-0.5745533550477158, -0.8184671295802987},
+		{-0.5758081914178453, -0.8175848131515837},
+		{-0.5770616728556794, -0.8167005728668278},
+		{-0.5783137964116556, -0.8158144108067338},
+		{-0.5795645591394056, -0.8149263290565266},
+		{-0.5808139580957645, -0.8140363297059484},
+		{-0.5820619903407754, -0.8131444148492536},
+		{-0.5833086529376983, -0.812250586585204},
+		{-0.5845539429530153, -0.8113548470170637},
+		{-0.5857978574564389, -0.8104571982525948},
+		{-0.587040393520918, -0.8095576424040513},
+		{-0.5882815482226452, -0.808656181588175},
+		{-0.5895213186410639, -0.8077528179261904},
+		{-0.5907597018588742, -0.8068475535437993},
+		{-0.591996694962041, -0.8059403905711763},
+		{-0.5932322950397998, -0.8050313311429637},
+		{-0.5944664991846644, -0.8041203773982658},
+		{-0.5956993044924334, -0.8032075314806449},
+		{-0.5969307080621965, -0.8022927955381157},
+		{-0.5981607069963424, -0.8013761717231402},
+		{-0.5993892984005645, -0.8004576621926228},
+		{-0.600616479383869, -0.799537269107905},
+		{-0.60184224705858, -0.7986149946347609},
+		{-0.6030665985403482, -0.7976908409433912},
+		{-0.604289530948156, -0.7967648102084187},
+		{-0.6055110414043255, -0.7958369046088836},
+		{-0.6067311270345245, -0.7949071263282369},
+		{-0.6079497849677736, -0.7939754775543372},
+		{-0.6091670123364532, -0.7930419604794436},
+		{-0.6103828062763095, -0.7921065773002124},
+		{-0.6115971639264619, -0.7911693302176902},
+		{-0.6128100824294097, -0.79023022143731},
+		{-0.6140215589310385, -0.7892892531688857},
+		{-0.6152315905806268, -0.7883464276266063},
+		{-0.6164401745308536, -0.7874017470290314},
+		{-0.6176473079378039, -0.7864552135990859},
+		{-0.6188529879609763, -0.7855068295640539},
+		{-0.6200572117632891, -0.7845565971555752},
+		{-0.6212599765110876, -0.7836045186096383},
+		{-0.62246127937415, -0.7826505961665757},
+		{-0.6236611175256945, -0.7816948320710595},
+		{-0.6248594881423863, -0.7807372285720945},
+		{-0.6260563884043435, -0.7797777879230146},
+		{-0.6272518154951441, -0.778816512381476},
+		{-0.6284457666018327, -0.7778534042094531},
+		{-0.629638238914927, -0.7768884656732324},
+		{-0.6308292296284245, -0.7759216990434077},
+		{-0.6320187359398091, -0.7749531065948739},
+		{-0.6332067550500572, -0.7739826906068228},
+		{-0.6343932841636455, -0.773010453362737},
+		{-0.6355783204885561, -0.7720363971503844},
+		{-0.6367618612362842, -0.7710605242618138},
+		{-0.637943903621844, -0.7700828369933479},
+		{-0.6391244448637757, -0.7691033376455796},
+		{-0.6403034821841517, -0.7681220285233654},
+		{-0.6414810128085832, -0.7671389119358205},
+		{-0.6426570339662269, -0.7661539901963129},
+		{-0.6438315428897914, -0.765167265622459},
+		{-0.6450045368155439, -0.7641787405361168},
+		{-0.6461760129833163, -0.7631884172633813},
+		{-0.6473459686365121, -0.7621962981345789},
+		{-0.6485144010221124, -0.7612023854842618},
+		{-0.6496813073906832, -0.7602066816512024},
+		{-0.650846684996381, -0.759209188978388},
+		{-0.6520105310969595, -0.7582099098130154},
+		{-0.6531728429537768, -0.7572088465064846},
+		{-0.6543336178318004, -0.7562060014143945},
+		{-0.6554928529996153, -0.7552013768965364},
+		{-0.656650545729429, -0.7541949753168892},
+		{-0.6578066932970786, -0.7531867990436125},
+		{-0.6589612929820373, -0.7521768504490427},
+		{-0.6601143420674205, -0.7511651319096864},
+		{-0.6612658378399923, -0.7501516458062151},
+		{-0.6624157775901718, -0.7491363945234594},
+		{-0.6635641586120398, -0.7481193804504036},
+		{-0.6647109782033448, -0.7471006059801801},
+		{-0.6658562336655097, -0.7460800735100638},
+		{-0.6669999223036375, -0.745057785441466},
+		{-0.6681420414265185, -0.7440337441799293},
+		{-0.669282588346636, -0.7430079521351217},
+		{-0.6704215603801731, -0.7419804117208311},
+		{-0.6715589548470183, -0.7409511253549591},
+		{-0.6726947690707729, -0.7399200954595162},
+		{-0.673829000378756, -0.7388873244606151},
+		{-0.674961646102012, -0.7378528147884661},
+		{-0.6760927035753159, -0.7368165688773699},
+		{-0.6772221701371803, -0.7357785891657136},
+		{-0.6783500431298615, -0.7347388780959635},
+		{-0.679476319899365, -0.7336974381146604},
+		{-0.680600997795453, -0.7326542716724129},
+		{-0.6817240741716497, -0.7316093812238926},
+		{-0.6828455463852481, -0.7305627692278276},
+		{-0.6839654117973155, -0.729514438146997},
+		{-0.6850836677727004, -0.7284643904482252},
+		{-0.6862003116800386, -0.7274126286023758},
+		{-0.687315340891759, -0.726359155084346},
+		{-0.6884287527840904, -0.7253039723730608},
+		{-0.6895405447370668, -0.724247082951467},
+		{-0.6906507141345346, -0.7231884893065275},
+		{-0.6917592583641577, -0.7221281939292153},
+		{-0.6928661748174246, -0.7210661993145081},
+		{-0.6939714608896539, -0.7200025079613818},
+		{-0.6950751139800009, -0.7189371223728045},
+		{-0.696177131491463, -0.7178700450557318},
+		{-0.6972775108308865, -0.7168012785210995},
+		{-0.6983762494089729, -0.7157308252838186},
+		{-0.6994733446402838, -0.7146586878627691},
+		{-0.7005687939432483, -0.7135848687807935},
+		{-0.7016625947401685, -0.7125093705646924},
+		{-0.7027547444572253, -0.7114321957452164},
+		{-0.7038452405244849, -0.7103533468570624},
+		{-0.7049340803759049, -0.7092728264388657},
+		{-0.7060212614493397, -0.7081906370331953},
+		{-0.7071067811865475, -0.7071067811865476},
+		{-0.7081906370331953, -0.7060212614493399},
+		{-0.7092728264388656, -0.704934080375905},
+		{-0.7103533468570624, -0.703845240524485},
+		{-0.7114321957452164, -0.7027547444572254},
+		{-0.7125093705646922, -0.7016625947401686},
+		{-0.7135848687807935, -0.7005687939432484},
+		{-0.7146586878627691, -0.6994733446402839},
+		{-0.7157308252838186, -0.6983762494089729},
+		{-0.7168012785210994, -0.6972775108308866},
+		{-0.7178700450557317, -0.6961771314914631},
+		{-0.7189371223728043, -0.6950751139800009},
+		{-0.7200025079613817, -0.693971460889654},
+		{-0.721066199314508, -0.6928661748174247},
+		{-0.7221281939292153, -0.6917592583641579},
+		{-0.7231884893065272, -0.6906507141345347},
+		{-0.7242470829514668, -0.6895405447370669},
+		{-0.7253039723730607, -0.6884287527840905},
+		{-0.7263591550843459, -0.6873153408917592},
+		{-0.7274126286023758, -0.6862003116800387},
+		{-0.7284643904482252, -0.6850836677727005},
+		{-0.7295144381469968, -0.6839654117973155},
+		{-0.7305627692278276, -0.6828455463852481},
+		{-0.7316093812238925, -0.6817240741716498},
+		{-0.7326542716724128, -0.6806009977954531},
+		{-0.7336974381146603, -0.6794763198993651},
+		{-0.7347388780959634, -0.6783500431298616},
+		{-0.7357785891657135, -0.6772221701371804},
+		{-0.7368165688773699, -0.676092703575316},
+		{-0.737852814788466, -0.674961646102012},
+		{-0.738887324460615, -0.6738290003787561},
+		{-0.739920095459516, -0.672694769070773},
+		{-0.7409511253549591, -0.6715589548470184},
+		{-0.741980411720831, -0.6704215603801732},
+		{-0.7430079521351217, -0.6692825883466361},
+		{-0.7440337441799293, -0.6681420414265186},
+		{-0.745057785441466, -0.6669999223036376},
+		{-0.7460800735100637, -0.6658562336655097},
+		{-0.7471006059801801, -0.6647109782033449},
+		{-0.7481193804504035, -0.6635641586120399},
+		{-0.7491363945234593, -0.6624157775901718},
+		{-0.750151645806215, -0.6612658378399923},
+		{-0.7511651319096864, -0.6601143420674206},
+		{-0.7521768504490427, -0.6589612929820373},
+		{-0.7531867990436125, -0.6578066932970787},
+		{-0.7541949753168892, -0.656650545729429},
+		{-0.7552013768965364, -0.6554928529996155},
+		{-0.7562060014143945, -0.6543336178318006},
+		{-0.7572088465064845, -0.6531728429537769},
+		{-0.7582099098130152, -0.6520105310969596},
+		{-0.7592091889783878, -0.650846684996381},
+		{-0.7602066816512024, -0.6496813073906833},
+		{-0.7612023854842617, -0.6485144010221126},
+		{-0.7621962981345789, -0.6473459686365122},
+		{-0.7631884172633812, -0.6461760129833164},
+		{-0.7641787405361167, -0.645004536815544},
+		{-0.765167265622459, -0.6438315428897915},
+		{-0.7661539901963128, -0.642657033966227},
+		{-0.7671389119358204, -0.6414810128085832},
+		{-0.7681220285233653, -0.6403034821841518},
+		{-0.7691033376455796, -0.6391244448637758},
+		{-0.7700828369933479, -0.6379439036218442},
+		{-0.7710605242618137, -0.6367618612362843},
+		{-0.7720363971503844, -0.6355783204885562},
+		{-0.7730104533627369, -0.6343932841636456},
+		{-0.7739826906068228, -0.6332067550500573},
+		{-0.7749531065948738, -0.6320187359398091},
+		{-0.7759216990434076, -0.6308292296284246},
+		{-0.7768884656732324, -0.6296382389149271},
+		{-0.777853404209453, -0.6284457666018327},
+		{-0.7788165123814759, -0.6272518154951442},
+		{-0.7797777879230144, -0.6260563884043436},
+		{-0.7807372285720944, -0.6248594881423865},
+		{-0.7816948320710594, -0.6236611175256946},
+		{-0.7826505961665756, -0.6224612793741501},
+		{-0.7836045186096382, -0.6212599765110877},
+		{-0.7845565971555751, -0.6200572117632892},
+		{-0.7855068295640539, -0.6188529879609764},
+		{-0.7864552135990858, -0.617647307937804},
+		{-0.7874017470290313, -0.6164401745308536},
+		{-0.7883464276266062, -0.6152315905806269},
+		{-0.7892892531688855, -0.6140215589310385},
+		{-0.79023022143731, -0.6128100824294098},
+		{-0.7911693302176901, -0.611597163926462},
+		{-0.7921065773002123, -0.6103828062763095},
+		{-0.7930419604794435, -0.6091670123364533},
+		{-0.7939754775543371, -0.6079497849677737},
+		{-0.794907126328237, -0.6067311270345246},
+		{-0.7958369046088835, -0.6055110414043255},
+		{-0.7967648102084187, -0.6042895309481561},
+		{-0.797690840943391, -0.6030665985403483},
+		{-0.7986149946347608, -0.6018422470585801},
+		{-0.7995372691079049, -0.600616479383869},
+		{-0.8004576621926227, -0.5993892984005647},
+		{-0.8013761717231402, -0.5981607069963424},
+		{-0.8022927955381157, -0.5969307080621965},
+		{-0.8032075314806448, -0.5956993044924335},
+		{-0.8041203773982657, -0.5944664991846645},
+		{-0.8050313311429637, -0.5932322950397999},
+		{-0.8059403905711762, -0.591996694962041},
+		{-0.8068475535437992, -0.5907597018588743},
+		{-0.8077528179261902, -0.5895213186410639},
+		{-0.808656181588175, -0.5882815482226453},
+		{-0.8095576424040513, -0.5870403935209181},
+		{-0.8104571982525947, -0.5857978574564389},
+		{-0.8113548470170636, -0.5845539429530154},
+		{-0.8122505865852039, -0.5833086529376984},
+		{-0.8131444148492535, -0.5820619903407755},
+		{-0.8140363297059483, -0.5808139580957646},
+		{-0.8149263290565265, -0.5795645591394057},
+		{-0.8158144108067337, -0.5783137964116557},
+		{-0.8167005728668277, -0.5770616728556796},
+		{-0.8175848131515836, -0.5758081914178454},
+		{-0.8184671295802987, -0.5745533550477159},
+		{-0.8193475200767969, -0.5732971666980423},
+		{-0.8202259825694346, -0.5720396293247572},
+		{-0.8211025149911046, -0.5707807458869674},
+		{-0.8219771152792416, -0.5695205193469473},
+		{-0.8228497813758263, -0.5682589526701316},
+		{-0.8237205112273913, -0.5669960488251087},
+		{-0.8245893027850253, -0.5657318107836132},
+		{-0.8254561540043774, -0.5644662415205195},
+		{-0.8263210628456634, -0.5631993440138342},
+		{-0.827184027273669, -0.5619311212446895},
+		{-0.8280450452577558, -0.560661576197336},
+		{-0.8289041147718649, -0.5593907118591361},
+		{-0.829761233794523, -0.5581185312205562},
+		{-0.8306164003088462, -0.5568450372751602},
+		{-0.8314696123025452, -0.5555702330196023},
+		{-0.8323208677679297, -0.5542941214536201},
+		{-0.8331701647019131, -0.5530167055800276},
+		{-0.8340175011060181, -0.5517379884047074},
+		{-0.83486287498638, -0.5504579729366049},
+		{-0.8357062843537526, -0.5491766621877198},
+		{-0.8365477272235119, -0.5478940591731003},
+		{-0.8373872016156618, -0.546610166910835},
+		{-0.838224705554838, -0.5453249884220466},
+		{-0.8390602370703126, -0.5440385267308839},
+		{-0.8398937941959994, -0.542750784864516},
+		{-0.840725374970458, -0.5414617658531236},
+		{-0.8415549774368983, -0.540171472729893},
+		{-0.8423825996431858, -0.5388799085310084},
+		{-0.8432082396418453, -0.5375870762956455},
+		{-0.8440318954900663, -0.5362929790659632},
+		{-0.844853565249707, -0.5349976198870974},
+		{-0.8456732469872991, -0.533701001807153},
+		{-0.8464909387740521, -0.532403127877198},
+		{-0.8473066386858582, -0.5311040011512551},
+		{-0.8481203448032971, -0.5298036246862948},
+		{-0.8489320552116396, -0.5285020015422285},
+		{-0.8497417680008524, -0.5271991347819014},
+		{-0.8505494812656034, -0.5258950274710849},
+		{-0.8513551931052652, -0.524589682678469},
+		{-0.8521589016239197, -0.5232831034756564},
+		{-0.8529606049303636, -0.5219752929371544},
+		{-0.8537603011381113, -0.5206662541403673},
+		{-0.8545579883654005, -0.5193559901655896},
+		{-0.8553536647351959, -0.5180445040959994},
+		{-0.8561473283751944, -0.51673179901765},
+		{-0.8569389774178287, -0.5154178780194631},
+		{-0.8577286100002721, -0.5141027441932218},
+		{-0.8585162242644427, -0.5127864006335631},
+		{-0.8593018183570084, -0.5114688504379705},
+		{-0.8600853904293901, -0.5101500967067668},
+		{-0.8608669386377672, -0.5088301425431071},
+		{-0.8616464611430813, -0.507508991052971},
+		{-0.8624239561110405, -0.5061866453451555},
+		{-0.8631994217121242, -0.5048631085312676},
+		{-0.8639728561215866, -0.5035383837257176},
+		{-0.8647442575194623, -0.5022124740457109},
+		{-0.865513624090569, -0.5008853826112409},
+		{-0.866280954024513, -0.4995571125450819},
+		{-0.8670462455156926, -0.4982276669727819},
+		{-0.8678094967633032, -0.4968970490226547},
+		{-0.868570705971341, -0.49556526182577254},
+		{-0.8693298713486068, -0.4942323085159598},
+		{-0.8700869911087113, -0.49289819222978415},
+		{-0.8708420634700789, -0.4915629161065501},
+		{-0.871595086655951, -0.49022648328829116},
+		{-0.8723460588943914, -0.4888888969197632},
+		{-0.87309497841829, -0.4875501601484361},
+		{-0.8738418434653668, -0.4862102761244866},
+		{-0.8745866522781761, -0.4848692480007911},
+		{-0.8753294031041108, -0.4835270789329188},
+		{-0.8760700941954065, -0.4821837720791229},
+		{-0.8768087238091457, -0.48083933060033396},
+		{-0.8775452902072612, -0.47949375766015306},
+		{-0.8782797916565415, -0.4781470564248431},
+		{-0.8790122264286334, -0.47679923006332225},
+		{-0.8797425928000474, -0.47545028174715587},
+		{-0.8804708890521608, -0.47410021465055},
+		{-0.8811971134712221, -0.4727490319503429},
+		{-0.8819212643483549, -0.4713967368259978},
+		{-0.8826433399795628, -0.4700433324595956},
+		{-0.8833633386657316, -0.46868882203582796},
+		{-0.884081258712635, -0.4673332087419885},
+		{-0.8847970984309379, -0.4659764957679661},
+		{-0.8855108561362, -0.4646186863062378},
+		{-0.8862225301488806, -0.46325978355186026},
+		{-0.8869321187943421, -0.46189979070246284},
+		{-0.8876396204028539, -0.46053871095824},
+		{-0.8883450333095962, -0.45917654752194415},
+		{-0.8890483558546645, -0.4578133035988773},
+		{-0.8897495863830728, -0.45644898239688386},
+		{-0.8904487232447579, -0.45508358712634384},
+		{-0.8911457647945833, -0.4537171210001639},
+		{-0.8918407093923426, -0.452349587233771},
+		{-0.8925335554027647, -0.4509809890451038},
+		{-0.8932243011955153, -0.4496113296546066},
+		{-0.8939129451452033, -0.44824061228522},
+		{-0.8945994856313826, -0.4468688401623743},
+		{-0.8952839210385576, -0.44549601651398174},
+		{-0.8959662497561852, -0.44412214457042926},
+		{-0.8966464701786802, -0.44274722756457013},
+		{-0.8973245807054183, -0.4413712687317166},
+		{-0.8980005797407399, -0.43999427130963326},
+		{-0.8986744656939538, -0.4386162385385277},
+		{-0.8993462369793415, -0.4372371736610442},
+		{-0.9000158920161602, -0.4358570799222555},
+		{-0.9006834292286469, -0.4344759605696557},
+		{-0.901348847046022, -0.433093818853152},
+		{-0.9020121439024932, -0.43171065802505737},
+		{-0.9026733182372588, -0.4303264813400826},
+		{-0.9033323684945118, -0.42894129205532955},
+		{-0.9039892931234433, -0.4275550934302822},
+		{-0.9046440905782461, -0.42616788872679956},
+		{-0.9052967593181187, -0.4247796812091088},
+		{-0.9059472978072685, -0.4233904741437961},
+		{-0.9065957045149153, -0.4220002707997998},
+		{-0.9072419779152958, -0.4206090744484025},
+		{-0.9078861164876663, -0.41921688836322396},
+		{-0.9085281187163061, -0.4178237158202124},
+		{-0.9091679830905224, -0.4164295600976373},
+		{-0.9098057081046523, -0.41503442447608163},
+		{-0.9104412922580671, -0.41363831223843456},
+		{-0.9110747340551762, -0.412241226669883},
+		{-0.91170603200543, -0.4108431710579039},
+		{-0.9123351846233227, -0.4094441486922576},
+		{-0.9129621904283982, -0.40804416286497874},
+		{-0.9135870479452508, -0.40664321687036914},
+		{-0.9142097557035307, -0.4052413140049898},
+		{-0.9148303122379462, -0.40383845756765413},
+		{-0.9154487160882678, -0.40243465085941854},
+		{-0.9160649657993316, -0.4010298971835758},
+		{-0.9166790599210427, -0.3996241998456468},
+		{-0.9172909970083779, -0.3982175621533736},
+		{-0.9179007756213905, -0.3968099874167104},
+		{-0.9185083943252123, -0.3954014789478163},
+		{-0.9191138516900577, -0.3939920400610481},
+		{-0.9197171462912272, -0.3925816740729515},
+		{-0.9203182767091106, -0.391170384302254},
+		{-0.9209172415291894, -0.3897581740698564},
+		{-0.921514039342042, -0.3883450466988263},
+		{-0.9221086687433452, -0.3869310055143887},
+		{-0.9227011283338785, -0.385516053843919},
+		{-0.9232914167195276, -0.38410019501693504},
+		{-0.9238795325112867, -0.38268343236508984},
+		{-0.9244654743252626, -0.3812657692221625},
+		{-0.9250492407826776, -0.3798472089240511},
+		{-0.9256308305098727, -0.37842775480876556},
+		{-0.9262102421383113, -0.3770074102164183},
+		{-0.9267874743045817, -0.3755861784892173},
+		{-0.9273625256504011, -0.37416406297145793},
+		{-0.9279353948226179, -0.3727410670095158},
+		{-0.9285060804732156, -0.3713171939518376},
+		{-0.9290745812593157, -0.3698924471489342},
+		{-0.9296408958431812, -0.3684668299533723},
+		{-0.930205022892219, -0.36704034571976724},
+		{-0.9307669610789836, -0.36561299780477396},
+		{-0.9313267090811804, -0.36418478956707984},
+		{-0.9318842655816681, -0.3627557243673972},
+		{-0.9324396292684624, -0.36132580556845434},
+		{-0.9329927988347387, -0.3598950365349883},
+		{-0.9335437729788363, -0.35846342063373654},
+		{-0.9340925504042589, -0.35703096123343003},
+		{-0.9346391298196808, -0.35559766170478396},
+		{-0.9351835099389475, -0.3541635254204905},
+		{-0.9357256894810804, -0.3527285557552107},
+		{-0.9362656671702783, -0.35129275608556715},
+		{-0.9368034417359216, -0.34985612979013503},
+		{-0.937339011912575, -0.3484186802494345},
+		{-0.9378723764399899, -0.3469804108459237},
+		{-0.9384035340631081, -0.34554132496398915},
+		{-0.9389324835320645, -0.344101425989939},
+		{-0.9394592236021899, -0.3426607173119944},
+		{-0.9399837530340139, -0.3412192023202824},
+		{-0.9405060705932683, -0.33977688440682696},
+		{-0.9410261750508893, -0.3383337669655413},
+		{-0.9415440651830208, -0.33688985339222005},
+		{-0.9420597397710174, -0.33544514708453166},
+		{-0.9425731976014468, -0.3339996514420095},
+		{-0.9430844374660935, -0.33255336986604417},
+		{-0.9435934581619604, -0.33110630575987643},
+		{-0.9441002584912727, -0.32965846252858755},
+		{-0.9446048372614801, -0.32820984357909266},
+		{-0.9451071932852606, -0.32676045232013173},
+		{-0.9456073253805213, -0.325310292162263},
+		{-0.9461052323704033, -0.32385936651785296},
+		{-0.9466009130832835, -0.32240767880106996},
+		{-0.9470943663527772, -0.3209552324278752},
+		{-0.9475855910177412, -0.31950203081601575},
+		{-0.9480745859222762, -0.31804807738501506},
+		{-0.9485613499157304, -0.31659337555616585},
+		{-0.9490458818527006, -0.31513792875252244},
+		{-0.9495281805930367, -0.3136817403988915},
+		{-0.950008245001843, -0.31222481392182505},
+		{-0.9504860739494817, -0.3107671527496115},
+		{-0.9509616663115751, -0.3093087603122687},
+		{-0.9514350209690083, -0.307849640041535},
+		{-0.9519061368079322, -0.3063897953708611},
+		{-0.9523750127197659, -0.3049292297354024},
+		{-0.9528416476011987, -0.30346794657201137},
+		{-0.9533060403541938, -0.3020059493192282},
+		{-0.9537681898859903, -0.3005432414172734},
+		{-0.9542280951091057, -0.2990798263080405},
+		{-0.9546857549413383, -0.2976157074350863},
+		{-0.9551411683057707, -0.29615088824362396},
+		{-0.9555943341307711, -0.2946853721805143},
+		{-0.9560452513499964, -0.2932191626942587},
+		{-0.956493918902395, -0.2917522632349894},
+		{-0.9569403357322089, -0.2902846772544623},
+		{-0.957384500788976, -0.2888164082060495},
+		{-0.9578264130275328, -0.28734745954472957},
+		{-0.9582660714080176, -0.2858778347270807},
+		{-0.9587034748958715, -0.2844075372112718},
+		{-0.9591386224618419, -0.2829365704570554},
+		{-0.9595715130819845, -0.28146493792575805},
+		{-0.9600021457376658, -0.2799926430802734},
+		{-0.9604305194155658, -0.27851968938505306},
+		{-0.9608566331076797, -0.27704608030609995},
+		{-0.9612804858113206, -0.27557181931095825},
+		{-0.9617020765291225, -0.27409690986870633},
+		{-0.9621214042690416, -0.272621355449949},
+		{-0.9625384680443592, -0.27114515952680807},
+		{-0.9629532668736839, -0.2696683255729152},
+		{-0.9633657997809542, -0.2681908570634031},
+		{-0.9637760657954398, -0.2667127574748984},
+		{-0.9641840639517457, -0.2652340302855119},
+		{-0.9645897932898128, -0.2637546789748315},
+		{-0.9649932528549203, -0.2622747070239136},
+		{-0.9653944416976894, -0.26079411791527557},
+		{-0.9657933588740836, -0.25931291513288635},
+		{-0.9661900034454125, -0.25783110216215893},
+		{-0.9665843744783331, -0.2563486824899429},
+		{-0.9669764710448521, -0.2548656596045146},
+		{-0.9673662922223284, -0.25338203699557027},
+		{-0.9677538370934755, -0.2518978181542169},
+		{-0.9681391047463623, -0.2504130065729653},
+		{-0.9685220942744173, -0.24892760574572026},
+		{-0.9689028047764289, -0.24744161916777344},
+		{-0.9692812353565485, -0.2459550503357946},
+		{-0.9696573851242924, -0.2444679027478242},
+		{-0.970031253194544, -0.24298017990326398},
+		{-0.9704028386875555, -0.24149188530286927},
+		{-0.9707721407289502, -0.2400030224487415},
+		{-0.9711391584497251, -0.2385135948443185},
+		{-0.9715038909862518, -0.23702360599436734},
+		{-0.9718663374802794, -0.23553305940497546},
+		{-0.9722264970789363, -0.23404195858354346},
+		{-0.9725843689347322, -0.23255030703877533},
+		{-0.9729399522055602, -0.23105810828067125},
+		{-0.9732932460546981, -0.22956536582051887},
+		{-0.9736442496508119, -0.2280720831708858},
+		{-0.973992962167956, -0.2265782638456101},
+		{-0.9743393827855759, -0.22508391135979278},
+		{-0.9746835106885107, -0.22358902922979},
+		{-0.9750253450669941, -0.2220936209732036},
+		{-0.975364885116657, -0.22059769010887365},
+		{-0.9757021300385286, -0.21910124015686977},
+		{-0.9760370790390391, -0.21760427463848367},
+		{-0.9763697313300211, -0.2161067970762196},
+		{-0.9767000861287118, -0.21460881099378692},
+		{-0.9770281426577543, -0.21311031991609136},
+		{-0.9773539001452, -0.2116113273692276},
+		{-0.9776773578245099, -0.21011183688046972},
+		{-0.9779985149345571, -0.20861185197826343},
+		{-0.9783173707196277, -0.20711137619221856},
+		{-0.9786339244294231, -0.20561041305309932},
+		{-0.9789481753190622, -0.204108966092817},
+		{-0.9792601226490821, -0.2026070388444211},
+		{-0.9795697656854406, -0.20110463484209193},
+		{-0.9798771036995176, -0.19960175762113105},
+		{-0.9801821359681173, -0.19809841071795373},
+		{-0.9804848617734694, -0.19659459767008022},
+		{-0.9807852804032304, -0.1950903220161283},
+		{-0.9810833911504867, -0.19358558729580372},
+		{-0.9813791933137546, -0.19208039704989238},
+		{-0.9816726861969831, -0.19057475482025277},
+		{-0.9819638691095552, -0.18906866414980628},
+		{-0.9822527413662894, -0.18756212858252974},
+		{-0.9825393022874412, -0.1860551516634466},
+		{-0.9828235511987053, -0.18454773693861964},
+		{-0.9831054874312164, -0.18303988795514103},
+		{-0.9833851103215512, -0.18153160826112513},
+		{-0.9836624192117303, -0.18002290140569951},
+		{-0.9839374134492189, -0.17851377093899756},
+		{-0.984210092386929, -0.17700422041214886},
+		{-0.9844804553832209, -0.17549425337727137},
+		{-0.9847485018019042, -0.17398387338746385},
+		{-0.9850142310122398, -0.17247308399679603},
+		{-0.9852776423889412, -0.17096188876030136},
+		{-0.9855387353121761, -0.16945029123396793},
+		{-0.9857975091675674, -0.1679382949747312},
+		{-0.9860539633461954, -0.16642590354046422},
+		{-0.9863080972445987, -0.16491312048997006},
+		{-0.9865599102647755, -0.16339994938297323},
+		{-0.9868094018141855, -0.16188639378011188},
+		{-0.987056571305751, -0.1603724572429284},
+		{-0.9873014181578584, -0.1588581433338614},
+		{-0.9875439417943592, -0.15734345561623828},
+		{-0.9877841416445722, -0.15582839765426532},
+		{-0.9880220171432835, -0.15431297301302024},
+		{-0.9882575677307495, -0.1527971852584434},
+		{-0.9884907928526967, -0.15128103795733025},
+		{-0.9887216919603238, -0.14976453467732162},
+		{-0.988950264510303, -0.1482476789868962},
+		{-0.989176509964781, -0.14673047445536175},
+		{-0.9894004277913803, -0.14521292465284752},
+		{-0.9896220174632008, -0.14369503315029458},
+		{-0.9898412784588205, -0.142176803519448},
+		{-0.9900582102622971, -0.14065823933284924},
+		{-0.9902728123631691, -0.13913934416382628},
+		{-0.990485084256457, -0.13762012158648618},
+		{-0.9906950254426646, -0.13610057517570617},
+		{-0.99090263542778, -0.13458070850712622},
+		{-0.9911079137232768, -0.13306052515713918},
+		{-0.9913108598461153, -0.13154002870288325},
+		{-0.991511473318744, -0.13001922272223335},
+		{-0.9917097536690995, -0.12849811079379322},
+		{-0.9919057004306093, -0.12697669649688598},
+		{-0.9920993131421918, -0.1254549834115462},
+		{-0.9922905913482573, -0.12393297511851219},
+		{-0.9924795345987101, -0.12241067519921628},
+		{-0.992666142448948, -0.12088808723577722},
+		{-0.992850414459865, -0.11936521481099134},
+		{-0.9930323501978514, -0.11784206150832502},
+		{-0.9932119492347945, -0.11631863091190486},
+		{-0.9933892111480807, -0.11479492660651025},
+		{-0.9935641355205953, -0.11327095217756435},
+		{-0.9937367219407246, -0.11174671121112666},
+		{-0.9939069700023561, -0.11022220729388318},
+		{-0.9940748793048794, -0.10869744401313867},
+		{-0.994240449453188, -0.10717242495680887},
+		{-0.9944036800576791, -0.1056471537134107},
+		{-0.9945645707342554, -0.10412163387205473},
+		{-0.9947231211043257, -0.10259586902243627},
+		{-0.9948793307948056, -0.10106986275482786},
+		{-0.9950331994381186, -0.09954361866006943},
+		{-0.9951847266721968, -0.09801714032956077},
+		{-0.9953339121404823, -0.09649043135525259},
+		{-0.9954807554919269, -0.09496349532963906},
+		{-0.9956252563809943, -0.09343633584574791},
+		{-0.9957674144676598, -0.0919089564971327},
+		{-0.9959072294174116, -0.09038136087786501},
+		{-0.996044700901252, -0.08885355258252468},
+		{-0.9961798285956969, -0.08732553520619221},
+		{-0.996312612182778, -0.08579731234443988},
+		{-0.9964430513500426, -0.08426888759332411},
+		{-0.9965711457905548, -0.0827402645493758},
+		{-0.996696895202896, -0.08121144680959239},
+		{-0.9968202992911658, -0.07968243797143013},
+		{-0.996941357764982, -0.0781532416327943},
+		{-0.997060070339483, -0.07662386139203162},
+		{-0.9971764367353261, -0.07509430084792128},
+		{-0.9972904566786902, -0.07356456359966745},
+		{-0.9974021299012753, -0.07203465324688942},
+		{-0.9975114561403035, -0.07050457338961401},
+		{-0.9976184351385196, -0.06897432762826673},
+		{-0.9977230666441916, -0.0674439195636641},
+		{-0.9978253504111116, -0.06591335279700392},
+		{-0.997925286198596, -0.06438263092985741},
+		{-0.9980228737714862, -0.06285175756416142},
+		{-0.9981181129001492, -0.061320736302208655},
+		{-0.9982110033604781, -0.05978957074664001},
+		{-0.9983015449338929, -0.05825826450043573},
+		{-0.9983897374073403, -0.05672682116690778},
+		{-0.9984755805732948, -0.05519524434969003},
+		{-0.9985590742297593, -0.05366353765273068},
+		{-0.9986402181802653, -0.05213170468028332},
+		{-0.9987190122338729, -0.05059974903689934},
+		{-0.9987954562051724, -0.04906767432741813},
+		{-0.9988695499142836, -0.04753548415695926},
+		{-0.9989412931868569, -0.046003182130914644},
+		{-0.9990106858540734, -0.044470771854938744},
+		{-0.9990777277526454, -0.04293825693494096},
+		{-0.9991424187248169, -0.04140564097707672},
+		{-0.9992047586183639, -0.039872927587739845},
+		{-0.9992647472865944, -0.03834012037355279},
+		{-0.9993223845883494, -0.03680722294135899},
+		{-0.9993776703880028, -0.03527423889821395},
+		{-0.9994306045554617, -0.03374117185137764},
+		{-0.999481186966167, -0.032208025408304704},
+		{-0.9995294175010931, -0.030674803176636584},
+		{-0.9995752960467492, -0.029141508764193743},
+		{-0.9996188224951786, -0.02760814577896582},
+		{-0.9996599967439592, -0.02607471782910404},
+		{-0.9996988186962041, -0.024541228522912267},
+		{-0.9997352882605618, -0.02300768146883941},
+		{-0.9997694053512153, -0.02147408027546961},
+		{-0.9998011698878841, -0.0199404285515146},
+		{-0.9998305817958234, -0.01840672990580482},
+		{-0.9998576410058239, -0.016872987947281773},
+		{-0.9998823474542126, -0.01533920628498822},
+		{-0.9999047010828528, -0.013805388528060349},
+		{-0.9999247018391446, -0.012271538285719944},
+		{-0.9999423496760239, -0.01073765916726457},
+		{-0.9999576445519639, -0.00920375478205996},
+		{-0.999970586430974, -0.007669828739531077},
+		{-0.9999811752826011, -0.006135884649154516},
+		{-0.9999894110819284, -0.004601926120448672},
+		{-0.9999952938095762, -0.003067956762966138},
+		{-0.9999988234517019, -0.001533980186284766},
+	},
+}
@@ -0,0 +1,26 @@
+package fft
+
+import "math"
+
+// UnwrapPhase returns phases with multiples of 2*pi added wherever
+// consecutive samples jump by more than pi, so the curve is continuous. The
+// first element is kept as is, and a jump spanning several wraps is removed
+// in one step. Jumps of exactly pi are left alone, as in numpy.unwrap.
+func UnwrapPhase(phases []float64) []float64 {
+	out := make([]float64, len(phases))
+	if len(phases) == 0 {
+		return out
+	}
+	out[0] = phases[0]
+	offset := 0.0
+	for i := 1; i < len(phases); i++ {
+		d := phases[i] - phases[i-1]
+		if math.Abs(d) > math.Pi {
+			// the equivalent step in [-pi, pi)
+			wrapped := d - 2*math.Pi*math.Floor((d+math.Pi)/(2*math.Pi))
+			offset += wrapped - d
+		}
+		out[i] = phases[i] + offset
+	}
+	return out
+}
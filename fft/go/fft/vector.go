@@ -0,0 +1,93 @@
+package fft
+
+import (
+	"fmt"
+	"math"
+)
+
+func ScaleInPlace(arr []Complex, s float64) {
+	scale(arr, s)
+}
+
+// Clone returns a copy of arr that shares no memory with it.
+func Clone(arr []Complex) []Complex {
+	out := make([]Complex, len(arr))
+	copy(out, arr)
+	return out
+}
+
+// Zero sets every element of arr to zero, e.g. to reuse it as scratch.
+func Zero(arr []Complex) {
+	clear(arr)
+}
+
+// Apply replaces each element of arr with fn(i, arr[i]).
+func Apply(arr []Complex, fn func(i int, c Complex) Complex) {
+	for i, c := range arr {
+		arr[i] = fn(i, c)
+	}
+}
+
+// AddInPlace adds b to a element by element.
+func AddInPlace(a, b []Complex) error {
+	if len(a) != len(b) {
+		return fmt.Errorf("fft: %w: %d and %d", ErrLengthMismatch, len(a), len(b))
+	}
+	for i := range a {
+		a[i] = a[i].Add(b[i])
+	}
+	return nil
+}
+
+// Validate returns ErrNonFinite, with the index, for the first element of
+// arr with a NaN or infinite component. A single NaN spreads to every bin of
+// a transform, so checking first pinpoints its source.
+func Validate(arr []Complex) error {
+	for i, c := range arr {
+		if c.IsNaN() || math.IsInf(c.Real, 0) || math.IsInf(c.Imag, 0) {
+			return fmt.Errorf("fft: %w %v at index %d", ErrNonFinite, c, i)
+		}
+	}
+	return nil
+}
+
+// Energy returns sum |x|^2. Because FFT is orthonormal, the energy of a
+// signal equals the energy of its spectrum (Parseval's theorem).
+func Energy(arr []Complex) float64 {
+	sum := 0.0
+	for _, c := range arr {
+		sum += c.Abs2()
+	}
+	return sum
+}
+
+// Dot returns the Hermitian inner product sum a[i] * conj(b[i]).
+func Dot(a, b []Complex) (Complex, error) {
+	if len(a) != len(b) {
+		return Complex{}, fmt.Errorf("fft: %w: %d and %d", ErrLengthMismatch, len(a), len(b))
+	}
+	var sum Complex
+	for i := range a {
+		sum = sum.Add(a[i].Mul(b[i].Conj()))
+	}
+	return sum, nil
+}
+
+// L2Norm returns sqrt(Dot(a, a)), the Euclidean length of a.
+func L2Norm(a []Complex) float64 {
+	return math.Sqrt(Energy(a))
+}
+
+// AllClose reports whether a and b have the same length and every pair of
+// elements is ApproxEqual within tol, so a tol of 0 uses Epsilon.
+func AllClose(a, b []Complex, tol float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].ApproxEqual(b[i], tol) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,19 @@
+package fft
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEnergyParseval(t *testing.T) {
+	for _, n := range []int{1, 8, 1024} {
+		x := randomSignal(n, int64(n))
+		spec := FFTCopy(x)
+		if e, f := Energy(x), Energy(spec); math.Abs(e-f) > 1e-9*e {
+			t.Errorf("n=%d: signal energy %g, spectrum energy %g", n, e, f)
+		}
+	}
+	if e := Energy(fromReals(3, 4)); e != 25 {
+		t.Errorf("Energy([3 4]) = %g, want 25", e)
+	}
+}
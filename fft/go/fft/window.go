@@ -0,0 +1,209 @@
+package fft
+
+import (
+	"fmt"
+	"math"
+)
+
+// Hann is the symmetric Hann window, zero at both ends, as used for filter
+// design. For n >= 3 its coherent gain is (n-1)/(2n), approaching 0.5;
+// Hann(n+1)[:n] is the periodic form, whose gain is exactly 0.5. Like every
+// window here, it is empty for n <= 0.
+func Hann(n int) []float64 {
+	return cosineWindow(n, 0.5, 0.5)
+}
+
+func Hamming(n int) []float64 {
+	return cosineWindow(n, 0.54, 0.46)
+}
+
+func Blackman(n int) []float64 {
+	return cosineWindow(n, 0.42, 0.5, 0.08)
+}
+
+func BlackmanHarris(n int) []float64 {
+	return cosineWindow(n, 0.35875, 0.48829, 0.14128, 0.01168)
+}
+
+// FlatTop is the 5-term flat-top window; its passband is flat enough that a
+// tone's amplitude reads correctly wherever it falls between bins.
+func FlatTop(n int) []float64 {
+	return cosineWindow(n, 0.21557895, 0.41663158, 0.277263158, 0.083578947, 0.006947368)
+}
+
+// Tukey is flat in the middle with cosine tapers over the outer alpha/2 of
+// each side: alpha = 0 is rectangular and alpha = 1 is Hann.
+func Tukey(n int, alpha float64) ([]float64, error) {
+	if alpha < 0 || alpha > 1 {
+		return nil, fmt.Errorf("fft: tukey alpha %g is outside [0, 1]", alpha)
+	}
+	if alpha == 1 {
+		return Hann(n), nil
+	}
+	w := make([]float64, max(n, 0))
+	for i := range w {
+		w[i] = 1
+	}
+	if alpha == 0 || n == 1 {
+		return w, nil
+	}
+	for i := range n {
+		x := float64(i) / float64(n-1)
+		switch {
+		case x < alpha/2:
+			w[i] = 0.5 * (1 + math.Cos(math.Pi*(2*x/alpha-1)))
+		case x > 1-alpha/2:
+			w[i] = 0.5 * (1 + math.Cos(math.Pi*(2*x/alpha-2/alpha+1)))
+		}
+	}
+	return w, nil
+}
+
+// Bartlett is the triangular window, zero at both ends.
+func Bartlett(n int) []float64 {
+	return polyWindow(n, func(x float64) float64 { return 1 - math.Abs(x) })
+}
+
+// WelchWindow is the parabolic Welch window, zero at both ends. It is not
+// the window of the Welch PSD estimate, which takes any window.
+func WelchWindow(n int) []float64 {
+	return polyWindow(n, func(x float64) float64 { return 1 - x*x })
+}
+
+// polyWindow builds the symmetric window shape(x) with x running from -1 to
+// 1 across the n samples.
+func polyWindow(n int, shape func(x float64) float64) []float64 {
+	w := make([]float64, max(n, 0))
+	if n == 1 {
+		w[0] = 1
+		return w
+	}
+	for i := range n {
+		w[i] = shape(float64(2*i-(n-1)) / float64(n-1))
+	}
+	return w
+}
+
+// Kaiser is the Kaiser-Bessel window; beta = 0 is rectangular and larger
+// beta trades a wider main lobe for lower sidelobes.
+func Kaiser(n int, beta float64) []float64 {
+	w := make([]float64, max(n, 0))
+	if n == 1 {
+		w[0] = 1
+		return w
+	}
+	norm := besselI0(beta)
+	for i := range n {
+		x := 2*float64(i)/float64(n-1) - 1
+		w[i] = besselI0(beta*math.Sqrt(1-x*x)) / norm
+	}
+	return w
+}
+
+// Chebyshev is the Dolph-Chebyshev window, whose sidelobes all sit atten dB
+// below the main lobe. Its samples are the inverse DFT of the Chebyshev
+// polynomial T_(n-1) evaluated around the unit circle, normalized to a peak
+// of 1.
+func Chebyshev(n int, atten float64) []float64 {
+	w := make([]float64, max(n, 0))
+	if n == 1 {
+		w[0] = 1
+		return w
+	}
+	order := float64(n - 1)
+	beta := math.Cosh(math.Acosh(math.Pow(10, atten/20)) / order)
+
+	spec := make([]Complex, max(n, 0))
+	for k := range spec {
+		x := beta * math.Cos(math.Pi*float64(k)/float64(n))
+		var p float64
+		switch {
+		case x > 1:
+			p = math.Cosh(order * math.Acosh(x))
+		case x < -1:
+			p = math.Cosh(order * math.Acosh(-x))
+			if n%2 == 0 {
+				p = -p // T_(n-1) is odd
+			}
+		default:
+			p = math.Cos(order * math.Acos(x))
+		}
+		spec[k] = Complex{p, 0}
+		if n%2 == 0 {
+			// a half-sample delay keeps the even-length window symmetric
+			spec[k] = spec[k].Mul(FromPolar(1, -math.Pi*float64(k)/float64(n)))
+		}
+	}
+	rawInverseTransform(spec)
+
+	// the transform is centred on index 0; unfold it around the middle
+	peak := 0.0
+	for i := range n {
+		j := i - n/2
+		if n%2 == 0 && j >= 0 {
+			j++
+		}
+		if j < 0 {
+			j = -j
+		}
+		w[i] = spec[j].Real
+		peak = max(peak, w[i])
+	}
+	for i := range w {
+		w[i] /= peak
+	}
+	return w
+}
+
+// besselI0 is the zeroth-order modified Bessel function of the first kind,
+// summed from its power series sum(((x/2)^k / k!)^2).
+func besselI0(x float64) float64 {
+	sum, term := 1.0, 1.0
+	for k := 1; term > 1e-17*sum; k++ {
+		term *= (x / 2) * (x / 2) / float64(k*k)
+		sum += term
+	}
+	return sum
+}
+
+// cosineWindow builds the symmetric window
+// a0 - a1*cos(x) + a2*cos(2x) - a3*cos(3x) + ... with x = 2*pi*i/(n-1).
+func cosineWindow(n int, coeffs ...float64) []float64 {
+	w := make([]float64, max(n, 0))
+	if n == 1 {
+		w[0] = 1
+		return w
+	}
+	for i := range n {
+		x := 2 * math.Pi * float64(i) / float64(n-1)
+		sign := 1.0
+		for k, a := range coeffs {
+			w[i] += sign * a * math.Cos(float64(k)*x)
+			sign = -sign
+		}
+	}
+	return w
+}
+
+func ApplyWindow(signal []Complex, window []float64) error {
+	if len(signal) != len(window) {
+		return fmt.Errorf("fft: %w: window length %d, signal length %d", ErrLengthMismatch, len(window), len(signal))
+	}
+	for i := range signal {
+		signal[i] = signal[i].MulScalar(window[i])
+	}
+	return nil
+}
+
+// CoherentGain is the mean of window, the factor by which the window scales
+// the amplitude of a sinusoid in the spectrum.
+func CoherentGain(window []float64) float64 {
+	if len(window) == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, w := range window {
+		sum += w
+	}
+	return sum / float64(len(window))
+}
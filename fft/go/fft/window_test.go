@@ -0,0 +1,173 @@
+package fft
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCoherentGain(t *testing.T) {
+	rect := make([]float64, 64)
+	for i := range rect {
+		rect[i] = 1
+	}
+	if g := CoherentGain(rect); g != 1 {
+		t.Errorf("rectangular gain = %g, want 1", g)
+	}
+	for _, n := range []int{3, 8, 64, 1000} {
+		want := float64(n-1) / float64(2*n)
+		if g := CoherentGain(Hann(n)); math.Abs(g-want) > 1e-12 {
+			t.Errorf("Hann(%d) gain = %g, want %g", n, g, want)
+		}
+		if g := CoherentGain(Hann(n + 1)[:n]); math.Abs(g-0.5) > 1e-12 {
+			t.Errorf("periodic Hann(%d) gain = %g, want 0.5", n, g)
+		}
+	}
+}
+
+func TestWindowsNonPositiveLength(t *testing.T) {
+	windows := map[string]func(int) []float64{
+		"Hann":           Hann,
+		"Hamming":        Hamming,
+		"Blackman":       Blackman,
+		"BlackmanHarris": BlackmanHarris,
+		"FlatTop":        FlatTop,
+		"Bartlett":       Bartlett,
+		"WelchWindow":    WelchWindow,
+		"Kaiser":         func(n int) []float64 { return Kaiser(n, 5) },
+		"Chebyshev":      func(n int) []float64 { return Chebyshev(n, 60) },
+	}
+	for name, window := range windows {
+		for _, n := range []int{0, -1} {
+			if w := window(n); len(w) != 0 {
+				t.Errorf("%s(%d) has length %d, want 0", name, n, len(w))
+			}
+		}
+		if w := window(1); len(w) != 1 || w[0] != 1 {
+			t.Errorf("%s(1) = %v, want [1]", name, w)
+		}
+	}
+}
+
+func TestBartlettAndWelchShape(t *testing.T) {
+	for _, n := range []int{2, 5, 8, 65} {
+		for name, w := range map[string][]float64{"Bartlett": Bartlett(n), "WelchWindow": WelchWindow(n)} {
+			if w[0] != 0 || w[n-1] != 0 {
+				t.Errorf("%s(%d) endpoints = %g, %g, want 0, 0", name, n, w[0], w[n-1])
+			}
+			for i := range n / 2 {
+				if math.Abs(w[i]-w[n-1-i]) > 1e-15 {
+					t.Errorf("%s(%d) not symmetric: w[%d] = %g, w[%d] = %g", name, n, i, w[i], n-1-i, w[n-1-i])
+				}
+			}
+			if n%2 == 1 && w[n/2] != 1 {
+				t.Errorf("%s(%d) centre = %g, want 1", name, n, w[n/2])
+			}
+		}
+	}
+	// quarter of the way in, the triangle is at 1/2 and the parabola at 3/4
+	if b, w := Bartlett(5)[1], WelchWindow(5)[1]; b != 0.5 || w != 0.75 {
+		t.Errorf("Bartlett(5)[1] = %g, WelchWindow(5)[1] = %g, want 0.5, 0.75", b, w)
+	}
+}
+
+// flatTopAmplitude windows a cosine of the given amplitude at a fractional
+// bin, then reads the amplitude back from the strongest bin, corrected by
+// the coherent gain.
+func flatTopAmplitude(n int, bin, amp float64) float64 {
+	w := FlatTop(n)
+	x := make([]Complex, n)
+	for i := range x {
+		x[i] = Complex{amp * math.Cos(2*math.Pi*bin*float64(i)/float64(n)) * w[i], 0}
+	}
+	FFT(x)
+	peak := 0.0
+	for _, c := range x[:n/2] {
+		peak = max(peak, c.Abs())
+	}
+	// 2/n turns a raw bin into a one-sided amplitude; FFT carries 1/sqrt(n)
+	return peak * math.Sqrt(float64(n)) * 2 / (float64(n) * CoherentGain(w))
+}
+
+func TestFlatTopAmplitudeAccuracy(t *testing.T) {
+	const amp = 1.7
+	for _, offset := range []float64{0, 0.1, 0.25, 0.4, 0.5} {
+		got := flatTopAmplitude(1024, 100+offset, amp)
+		limit := 0.001
+		if offset == 0.5 {
+			limit = 0.0012 // the worst case, exactly between two bins, is about 0.11%
+		}
+		if rel := math.Abs(got-amp) / amp; rel > limit {
+			t.Errorf("offset %g bins: amplitude %g, off by %.3f%%", offset, got, 100*rel)
+		}
+	}
+}
+
+func TestBesselI0(t *testing.T) {
+	tests := []struct{ x, want float64 }{
+		{0, 1},
+		{1, 1.2660658777520082},
+		{2, 2.2795853023360673},
+		{5, 27.239871823604442},
+		{10, 2815.716628466254},
+	}
+	for _, tt := range tests {
+		if got := besselI0(tt.x); math.Abs(got-tt.want) > 1e-14*tt.want {
+			t.Errorf("besselI0(%g) = %.17g, want %.17g", tt.x, got, tt.want)
+		}
+	}
+}
+
+// peakSidelobe returns the highest sidelobe of window relative to its main
+// lobe, as a magnitude ratio, from a zero-padded spectrum.
+func peakSidelobe(window []float64) float64 {
+	spec := make([]Complex, 16*NextPow2(len(window)))
+	for i, w := range window {
+		spec[i] = Complex{w, 0}
+	}
+	FFT(spec)
+	mags := make([]float64, len(spec)/2)
+	for i := range mags {
+		mags[i] = spec[i].Abs()
+	}
+	k := 1
+	for k < len(mags) && mags[k] <= mags[k-1] {
+		k++ // walk down the main lobe to its first null
+	}
+	side := 0.0
+	for _, m := range mags[k:] {
+		side = max(side, m)
+	}
+	return side / mags[0]
+}
+
+func TestKaiser(t *testing.T) {
+	for _, v := range Kaiser(33, 0) {
+		if v != 1 {
+			t.Fatalf("Kaiser(33, 0) = %v, want all ones", Kaiser(33, 0))
+		}
+	}
+	prev := peakSidelobe(Kaiser(64, 0))
+	for _, beta := range []float64{2, 4, 6, 8} {
+		side := peakSidelobe(Kaiser(64, beta))
+		if side >= prev {
+			t.Errorf("beta %g: peak sidelobe %g, not below %g at the previous beta", beta, side, prev)
+		}
+		prev = side
+	}
+}
+
+func TestChebyshevSidelobes(t *testing.T) {
+	for _, n := range []int{63, 64} {
+		prev := 0.0
+		for _, atten := range []float64{40, 60, 80, 100} {
+			side := 20 * math.Log10(peakSidelobe(Chebyshev(n, atten)))
+			if math.Abs(side+atten) > 0.5 {
+				t.Errorf("n=%d atten %g: peak sidelobe %.2f dB, want %g dB", n, atten, side, -atten)
+			}
+			if prev != 0 && side >= prev {
+				t.Errorf("n=%d atten %g: peak sidelobe %.2f dB, not below %.2f dB at the previous atten", n, atten, side, prev)
+			}
+			prev = side
+		}
+	}
+}
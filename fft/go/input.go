@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	f "main/fft"
+)
+
+// readInput parses one "real,imag" pair per line from path, or from stdin
+// when path is "-". Blank lines are skipped.
+func readInput(path string) ([]f.Complex, error) {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		r = file
+	}
+
+	var signals []f.Complex
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		fields := strings.Split(text, ",")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("%s:%d: expected real,imag, got %q", path, line, text)
+		}
+		re, err := strconv.ParseFloat(strings.TrimSpace(fields[0]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: invalid real part: %w", path, line, err)
+		}
+		im, err := strconv.ParseFloat(strings.TrimSpace(fields[1]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: invalid imaginary part: %w", path, line, err)
+		}
+		signals = append(signals, f.Complex{Real: re, Imag: im})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	n := len(signals)
+	if !f.IsPow2(n) {
+		return nil, fmt.Errorf("%s: got %d samples; count must be a non-zero power of two", path, n)
+	}
+	return signals, nil
+}
@@ -1,9 +1,14 @@
 package main
 
 import (
+	"encoding/json"
+	"flag"
 	"fmt"
 	"math"
 	"os"
+	"runtime"
+	"runtime/debug"
+	"slices"
 	"strconv"
 	"time"
 
@@ -18,25 +23,291 @@ import (
 // 	}
 // }
 
+type result struct {
+	Size     int      `json:"size"`
+	Runs     int      `json:"runs"`
+	TimeMs   float64  `json:"time_ms"`
+	MinMs    float64  `json:"min_ms"`
+	MedianMs float64  `json:"median_ms"`
+	MFLOPS   float64  `json:"mflops"`
+	Allocs   float64  `json:"allocs"`
+	Bytes    float64  `json:"alloc_bytes"`
+	MaxError *float64 `json:"max_error,omitempty"`
+
+	VerifyError *float64 `json:"verify_error,omitempty"`
+	VerifyPass  *bool    `json:"verify_pass,omitempty"`
+}
+
 func main() {
-	if len(os.Args) < 2 {
-		fmt.Fprintf(os.Stderr, "usage: %s <size>\n", os.Args[0])
+	format := flag.String("format", "text", "output `format`: text, json or csv")
+	warmup := flag.Int("warmup", 0, "number of untimed warmup transforms")
+	runs := flag.Int("runs", 1, "number of timed transforms")
+	inverse := flag.Bool("inverse", false, "time IFFT instead of FFT")
+	roundtrip := flag.Bool("roundtrip", false, "time FFT followed by IFFT and report the maximum reconstruction error")
+	signal := flag.String("signal", "tones", "generated input: tones, sine, noise, impulse or chirp")
+	seed := flag.Int64("seed", 1, "random seed for -signal noise")
+	noGC := flag.Bool("no-gc", false, "disable the garbage collector during the timed runs")
+	input := flag.String("input", "", "read real,imag CSV lines from `path` (- for stdin) instead of generating <size>")
+	output := flag.String("output", "", "write the result of the last run to `path` (- for stdout) as real,imag CSV lines")
+	wav := flag.String("wav", "", "read a mono 16-bit PCM WAV file from `path` (- for stdin) instead of generating <size>, using its largest power-of-two prefix")
+	outputWAV := flag.String("output-wav", "", "with -wav and -roundtrip, write the round-tripped signal of the last run to `path` (- for stdout) as WAV")
+	outputFreq := flag.Bool("output-freq", false, "with -output, write freq,magnitude lines instead, in cycles per sample")
+	verify := flag.String("verify", "", "compare the result of the last run with real,imag CSV lines in `path` and exit with status 1 if they differ by more than -tol")
+	tol := flag.Float64("tol", 1e-9, "maximum absolute error per element accepted by -verify")
+	sweep := flag.String("sweep", "", "time every size from lo to hi, given as `lo:hi`, instead of a single <size>")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: %s [flags] <size>\n       %s [flags] -sweep lo:hi\n", os.Args[0], os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if *format != "text" && *format != "json" && *format != "csv" {
+		fmt.Fprintf(os.Stderr, "invalid -format %q; must be text, json or csv\n", *format)
+		os.Exit(1)
+	}
+	if *inverse && *roundtrip {
+		fmt.Fprintln(os.Stderr, "-inverse and -roundtrip are mutually exclusive")
+		os.Exit(1)
+	}
+	if *input != "" && *wav != "" {
+		fmt.Fprintln(os.Stderr, "-input and -wav are mutually exclusive")
+		os.Exit(1)
+	}
+	if *outputWAV != "" && (*wav == "" || !*roundtrip) {
+		fmt.Fprintln(os.Stderr, "-output-wav requires -wav and -roundtrip")
+		os.Exit(1)
+	}
+	if *outputFreq && *output == "" {
+		fmt.Fprintln(os.Stderr, "-output-freq requires -output")
+		os.Exit(1)
+	}
+	tolSet := false
+	flag.Visit(func(fl *flag.Flag) { tolSet = tolSet || fl.Name == "tol" })
+	if tolSet && *verify == "" {
+		fmt.Fprintln(os.Stderr, "-tol requires -verify")
+		os.Exit(1)
+	}
+	if *tol < 0 || math.IsNaN(*tol) {
+		fmt.Fprintln(os.Stderr, "invalid -tol; must be non-negative")
 		os.Exit(1)
 	}
-	size, err := strconv.Atoi(os.Args[1])
-	if err != nil || size < 0 {
-		fmt.Fprintln(os.Stderr, "invalid <size>; must be a non-negative integer")
+	if *verify == "-" && (*input == "-" || *wav == "-") {
+		fmt.Fprintln(os.Stderr, "-verify cannot read stdin along with -input or -wav")
+		os.Exit(1)
+	}
+	if *warmup < 0 || *runs < 1 {
+		fmt.Fprintln(os.Stderr, "invalid -warmup/-runs; need warmup >= 0 and runs >= 1")
 		os.Exit(1)
 	}
-	n := 1 << uint(size)
 
-	signals := generateInputs(n)
+	var sweepLo, sweepHi int
+	if *sweep != "" {
+		if *input != "" || *wav != "" || *output != "" || *verify != "" || flag.NArg() > 0 {
+			fmt.Fprintln(os.Stderr, "-sweep cannot be combined with -input, -wav, -output, -verify or <size>")
+			os.Exit(1)
+		}
+		var err error
+		sweepLo, sweepHi, err = parseSweep(*sweep)
+		if err == nil {
+			_, err = makeSignal(*signal, 1, *seed) // reject a bad -signal before printing a header
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
 
-	start := time.Now()
-	f.FFT(signals) // assumes in-place transform over []Complex
-	elapsed := time.Since(start)
-  ms := float64(elapsed.Nanoseconds()) / 1_000_000.0
-	fmt.Printf("execution time: %.3f ms\n", ms)
+	var signals []f.Complex
+	sampleRate := 0
+	switch {
+	case *sweep != "":
+		// generated per size in runSweep
+	case *input != "":
+		var err error
+		signals, err = readInput(*input)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	case *wav != "":
+		var err error
+		signals, sampleRate, err = readWAV(*wav)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	default:
+		if flag.NArg() < 1 {
+			flag.Usage()
+			os.Exit(1)
+		}
+		size, err := strconv.Atoi(flag.Arg(0))
+		if err != nil || size < 0 {
+			fmt.Fprintln(os.Stderr, "invalid <size>; must be a non-negative integer")
+			os.Exit(1)
+		}
+		if err := checkSize(size); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		signals, err = makeSignal(*signal, 1<<uint(size), *seed)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	var reference []f.Complex
+	if *verify != "" {
+		var err error
+		reference, err = readInput(*verify)
+		if err == nil && len(reference) != len(signals) {
+			err = fmt.Errorf("-verify: %s has %d values, want %d", *verify, len(reference), len(signals))
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	transform, passes := f.FFT[float64], 1
+	switch {
+	case *inverse:
+		transform = f.IFFT[float64]
+	case *roundtrip:
+		transform, passes = func(arr []f.Complex) {
+			f.FFT(arr)
+			f.IFFT(arr)
+		}, 2
+	}
+
+	if *sweep != "" {
+		runSweep(sweepLo, sweepHi, func(n int) ([]f.Complex, error) {
+			return makeSignal(*signal, n, *seed)
+		}, transform, passes, *warmup, *runs, *noGC, *roundtrip, *format)
+		return
+	}
+
+	res, work := measure(signals, transform, passes, *warmup, *runs, *noGC, *roundtrip)
+	if *verify != "" {
+		e := 0.0
+		for i := range work {
+			e = max(e, work[i].Sub(reference[i]).Abs())
+		}
+		pass := e <= *tol
+		res.VerifyError, res.VerifyPass = &e, &pass
+		if !pass {
+			defer os.Exit(1) // after the results are printed, whatever the format
+		}
+	}
+
+	if *output != "" {
+		if err := writeOutput(*output, work, *outputFreq); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+	if *outputWAV != "" {
+		if err := writeWAV(*outputWAV, work, sampleRate); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	switch *format {
+	case "json":
+		out, _ := json.Marshal(res)
+		fmt.Println(string(out))
+		return
+	case "csv":
+		printCSV([]result{res})
+		return
+	}
+	if *runs == 1 {
+		fmt.Printf("execution time: %.3f ms\n", res.TimeMs)
+	} else {
+		fmt.Printf("execution time: %.3f ms (mean of %d runs; min %.3f ms, median %.3f ms)\n", res.TimeMs, *runs, res.MinMs, res.MedianMs)
+	}
+	fmt.Printf("throughput: %.1f MFLOPS\n", res.MFLOPS)
+	fmt.Printf("allocations: %.2f (%.0f bytes) per run\n", res.Allocs, res.Bytes)
+	if res.MaxError != nil {
+		fmt.Printf("max roundtrip error: %.3e\n", *res.MaxError)
+	}
+	if res.VerifyError != nil {
+		verdict := "FAIL"
+		if *res.VerifyPass {
+			verdict = "PASS"
+		}
+		fmt.Printf("verify: max error %.3e (tol %.3e) %s\n", *res.VerifyError, *tol, verdict)
+	}
+}
+
+// measure times runs transforms of signals after warmup untimed ones and
+// returns the summary along with the output of the last run. Every run
+// transforms a fresh copy, since transforms are in place.
+func measure(signals []f.Complex, transform func([]f.Complex), passes, warmup, runs int, noGC, roundtrip bool) (result, []f.Complex) {
+	work := make([]f.Complex, len(signals))
+	for range warmup {
+		copy(work, signals)
+		transform(work)
+	}
+
+	times := make([]float64, 0, runs)
+	var before, after runtime.MemStats
+	if noGC {
+		runtime.GC()
+		defer debug.SetGCPercent(debug.SetGCPercent(-1))
+	}
+	runtime.ReadMemStats(&before)
+	for range runs {
+		copy(work, signals)
+		start := time.Now()
+		transform(work) // assumes in-place transform over []Complex
+		elapsed := time.Since(start)
+		times = append(times, float64(elapsed.Nanoseconds())/1_000_000.0)
+	}
+	runtime.ReadMemStats(&after)
+	lo, median, mean := summarize(times)
+	mflops := 0.0
+	if mean > 0 {
+		mflops = float64(passes) * flops(len(signals)) / (mean * 1000)
+	}
+	res := result{
+		Size:     len(signals),
+		Runs:     runs,
+		TimeMs:   math.Round(mean*1000) / 1000,
+		MinMs:    math.Round(lo*1000) / 1000,
+		MedianMs: math.Round(median*1000) / 1000,
+		MFLOPS:   math.Round(mflops*10) / 10,
+		Allocs:   float64(after.Mallocs-before.Mallocs) / float64(runs),
+		Bytes:    float64(after.TotalAlloc-before.TotalAlloc) / float64(runs),
+	}
+	if roundtrip {
+		e := 0.0
+		for i := range work {
+			e = max(e, work[i].Sub(signals[i]).Abs())
+		}
+		res.MaxError = &e
+	}
+	return res, work
+}
+
+// flops is the conventional operation count of a radix-2 FFT of n points,
+// 5*n*log2(n).
+func flops(n int) float64 {
+	return 5 * float64(n) * math.Log2(float64(n))
+}
+
+func summarize(times []float64) (lo, median, mean float64) {
+	sorted := slices.Sorted(slices.Values(times))
+	n := len(sorted)
+	median = sorted[n/2]
+	if n%2 == 0 {
+		median = (sorted[n/2-1] + sorted[n/2]) / 2
+	}
+	for _, t := range sorted {
+		mean += t
+	}
+	return sorted[0], median, mean / float64(n)
 }
 
 func round(n float64) float64 {
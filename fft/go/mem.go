@@ -0,0 +1,20 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// checkSize rejects a <size> whose 16-byte-per-point []Complex buffer could
+// not fit in physical memory, so oversized runs fail with a message instead
+// of an out-of-memory crash.
+func checkSize(size int) error {
+	if size > 58 {
+		return fmt.Errorf("size too large: would require %.0f bytes", 16*math.Exp2(float64(size)))
+	}
+	need := uint64(16) << uint(size)
+	if mem := physicalMemory(); mem > 0 && need > mem {
+		return fmt.Errorf("size too large: would require %d bytes, have %d", need, mem)
+	}
+	return nil
+}
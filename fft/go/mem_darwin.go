@@ -0,0 +1,18 @@
+package main
+
+import (
+	"encoding/binary"
+	"syscall"
+)
+
+func physicalMemory() uint64 {
+	raw, err := syscall.Sysctl("hw.memsize")
+	if err != nil {
+		return 0
+	}
+	// Sysctl drops a trailing zero byte, so pad the little-endian value back
+	// out to 8 bytes
+	buf := make([]byte, 8)
+	copy(buf, raw)
+	return binary.LittleEndian.Uint64(buf)
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+
+	f "main/fft"
+)
+
+// writeOutput writes spectrum to path, or to stdout when path is "-", as one
+// "real,imag" pair per line. With freq it writes "freq,magnitude" instead,
+// with frequencies in cycles per sample in FreqBins order.
+func writeOutput(path string, spectrum []f.Complex, freq bool) (err error) {
+	var w io.Writer = os.Stdout
+	if path != "-" {
+		file, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := file.Close(); err == nil {
+				err = cerr
+			}
+		}()
+		w = file
+	}
+
+	bw := bufio.NewWriter(w)
+	freqs := f.FreqBins(len(spectrum), 1)
+	for i, c := range spectrum {
+		if freq {
+			fmt.Fprintf(bw, "%g,%g\n", freqs[i], c.Abs())
+		} else {
+			fmt.Fprintf(bw, "%g,%g\n", c.Real, c.Imag)
+		}
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	f "main/fft"
+)
+
+// makeSignal builds a length-n input for the named -signal kind. seed only
+// affects "noise".
+func makeSignal(kind string, n int, seed int64) ([]f.Complex, error) {
+	switch kind {
+	case "tones":
+		return generateInputs(n), nil
+	case "sine":
+		return generateSine(n), nil
+	case "noise":
+		return generateNoise(n, seed), nil
+	case "impulse":
+		return generateImpulse(n), nil
+	case "chirp":
+		return f.Chirp(n, 0, 0.5), nil
+	}
+	return nil, fmt.Errorf("invalid -signal %q; must be tones, sine, noise, impulse or chirp", kind)
+}
+
+func generateSine(n int) []f.Complex {
+	inputs := make([]f.Complex, n)
+	for i := range n {
+		inputs[i] = f.Complex{Real: math.Sin(2 * math.Pi * 5 * float64(i) / float64(n))}
+	}
+	return inputs
+}
+
+// generateNoise draws white Gaussian noise for both components.
+func generateNoise(n int, seed int64) []f.Complex {
+	r := rand.New(rand.NewSource(seed))
+	inputs := make([]f.Complex, n)
+	for i := range n {
+		inputs[i] = f.Complex{Real: r.NormFloat64(), Imag: r.NormFloat64()}
+	}
+	return inputs
+}
+
+func generateImpulse(n int) []f.Complex {
+	inputs := make([]f.Complex, n)
+	if n > 0 {
+		inputs[0] = f.Complex{Real: 1}
+	}
+	return inputs
+}
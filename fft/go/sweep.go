@@ -0,0 +1,78 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"os"
+	"strconv"
+	"strings"
+
+	f "main/fft"
+)
+
+// parseSweep parses a -sweep range of the form lo:hi, both inclusive
+// log2 sizes.
+func parseSweep(s string) (lo, hi int, err error) {
+	a, b, ok := strings.Cut(s, ":")
+	if ok {
+		lo, err = strconv.Atoi(a)
+		if err == nil {
+			hi, err = strconv.Atoi(b)
+		}
+	}
+	if !ok || err != nil || lo < 0 || lo > hi {
+		return 0, 0, fmt.Errorf("invalid -sweep %q; must be lo:hi with 0 <= lo <= hi", s)
+	}
+	if err := checkSize(hi); err != nil {
+		return 0, 0, err
+	}
+	return lo, hi, nil
+}
+
+// runSweep measures every size from lo to hi on a freshly generated input
+// and prints one row per size as soon as it is done.
+func runSweep(lo, hi int, generate func(n int) ([]f.Complex, error), transform func([]f.Complex), passes, warmup, runs int, noGC, roundtrip bool, format string) {
+	switch format {
+	case "text":
+		fmt.Printf("%5s %12s %12s %10s\n", "log2n", "n", "time_ms", "MFLOPS")
+	case "csv":
+		fmt.Println(csvHeader)
+	}
+	for size := lo; size <= hi; size++ {
+		signals, err := generate(1 << uint(size))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		res, _ := measure(signals, transform, passes, warmup, runs, noGC, roundtrip)
+		switch format {
+		case "json":
+			out, _ := json.Marshal(res)
+			fmt.Println(string(out))
+		case "csv":
+			fmt.Println(csvRow(res))
+		default:
+			fmt.Printf("%5d %12d %12.3f %10.1f\n", log2(res.Size), res.Size, res.TimeMs, res.MFLOPS)
+		}
+	}
+}
+
+const csvHeader = "log2n,n,time_ms,mflops"
+
+func printCSV(rows []result) {
+	fmt.Println(csvHeader)
+	for _, r := range rows {
+		fmt.Println(csvRow(r))
+	}
+}
+
+func csvRow(r result) string {
+	return fmt.Sprintf("%d,%d,%.3f,%.1f", log2(r.Size), r.Size, r.TimeMs, r.MFLOPS)
+}
+
+// log2 is the size exponent of an n-point input, rounded down for inputs
+// read from a file that are not a power of two.
+func log2(n int) int {
+	return bits.Len(uint(n)) - 1
+}
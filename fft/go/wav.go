@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+	"os"
+
+	f "main/fft"
+)
+
+// wavHeaderSize is the length of the canonical PCM WAV header: a RIFF chunk
+// holding a 16-byte fmt chunk followed directly by the data chunk.
+const wavHeaderSize = 44
+
+// readWAV reads a mono 16-bit PCM WAV file with the canonical header from
+// path, or from stdin when path is "-". Samples are scaled to [-1, 1) and
+// cut to the largest power of two that fits, since the transforms need a
+// power-of-two length.
+func readWAV(path string) (signals []f.Complex, sampleRate int, err error) {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, 0, err
+		}
+		defer file.Close()
+		r = file
+	}
+
+	var h [wavHeaderSize]byte
+	if _, err := io.ReadFull(r, h[:]); err != nil {
+		return nil, 0, fmt.Errorf("%s: reading WAV header: %w", path, err)
+	}
+	le := binary.LittleEndian
+	switch {
+	case string(h[0:4]) != "RIFF" || string(h[8:12]) != "WAVE" || string(h[12:16]) != "fmt " || le.Uint32(h[16:20]) != 16 || string(h[36:40]) != "data":
+		return nil, 0, fmt.Errorf("%s: not a canonical 44-byte PCM WAV header", path)
+	case le.Uint16(h[20:22]) != 1:
+		return nil, 0, fmt.Errorf("%s: WAV format %d is not PCM", path, le.Uint16(h[20:22]))
+	case le.Uint16(h[22:24]) != 1:
+		return nil, 0, fmt.Errorf("%s: WAV has %d channels; only mono is supported", path, le.Uint16(h[22:24]))
+	case le.Uint16(h[34:36]) != 16:
+		return nil, 0, fmt.Errorf("%s: WAV has %d bits per sample; only 16 is supported", path, le.Uint16(h[34:36]))
+	}
+	sampleRate = int(le.Uint32(h[24:28]))
+
+	data, err := io.ReadAll(io.LimitReader(r, int64(le.Uint32(h[40:44]))))
+	if err != nil {
+		return nil, 0, err
+	}
+	count := len(data) / 2
+	if count == 0 {
+		return nil, 0, fmt.Errorf("%s: WAV has no samples", path)
+	}
+	n := 1 << (bits.Len(uint(count)) - 1)
+	signals = make([]f.Complex, n)
+	for i := range signals {
+		signals[i].Real = float64(int16(le.Uint16(data[2*i:]))) / 32768
+	}
+	return signals, sampleRate, nil
+}
+
+// writeWAV writes the real parts of signals to path, or to stdout when path
+// is "-", as a mono 16-bit PCM WAV file. Values are scaled as readWAV reads
+// them, so its samples round-trip exactly, and clamped to the 16-bit range.
+func writeWAV(path string, signals []f.Complex, sampleRate int) (err error) {
+	var w io.Writer = os.Stdout
+	if path != "-" {
+		file, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := file.Close(); err == nil {
+				err = cerr
+			}
+		}()
+		w = file
+	}
+
+	size := 2 * len(signals)
+	var h [wavHeaderSize]byte
+	le := binary.LittleEndian
+	copy(h[0:4], "RIFF")
+	le.PutUint32(h[4:8], uint32(wavHeaderSize-8+size))
+	copy(h[8:16], "WAVEfmt ")
+	le.PutUint32(h[16:20], 16)
+	le.PutUint16(h[20:22], 1) // PCM
+	le.PutUint16(h[22:24], 1) // mono
+	le.PutUint32(h[24:28], uint32(sampleRate))
+	le.PutUint32(h[28:32], uint32(2*sampleRate)) // bytes per second
+	le.PutUint16(h[32:34], 2)                    // bytes per sample frame
+	le.PutUint16(h[34:36], 16)
+	copy(h[36:40], "data")
+	le.PutUint32(h[40:44], uint32(size))
+
+	bw := bufio.NewWriter(w)
+	bw.Write(h[:])
+	var sample [2]byte
+	for _, c := range signals {
+		v := min(max(math.Round(c.Real*32768), math.MinInt16), math.MaxInt16)
+		le.PutUint16(sample[:], uint16(int16(v)))
+		bw.Write(sample[:])
+	}
+	return bw.Flush()
+}